@@ -0,0 +1,96 @@
+package poner
+
+import (
+	"fmt"
+	"math/bits"
+)
+
+// CardID is a card as a single byte, its suit index times 13 plus its order,
+// which is the card's position in a new deck
+type CardID uint8
+
+// NoCard is the CardID of anything that isn't a valid card
+const NoCard CardID = 255
+
+// CardMask is a set of cards with one bit for each CardID
+type CardMask uint64
+
+// ID returns the card's CardID, or NoCard if it isn't a valid card
+func (card Card) ID() CardID {
+	index := card.index()
+	if index < 0 {
+		return NoCard
+	}
+	return CardID(index)
+}
+
+// Card returns the card of a CardID
+func (id CardID) Card() Card {
+	if id >= 52 {
+		return Card{}
+	}
+	return cardFromIndex(int(id))
+}
+
+// Order returns the order of the card's rank, 0 for an ace up to 12 for a king
+func (id CardID) Order() int {
+	return int(id) % 13
+}
+
+// Suit returns the index of the card's suit
+func (id CardID) Suit() int {
+	return int(id) / 13
+}
+
+// Mask returns the set holding just the card
+func (id CardID) Mask() CardMask {
+	if id >= 52 {
+		return 0
+	}
+	return 1 << id
+}
+
+// Mask returns the set of a hand's cards, with an error for invalid or repeated cards
+func (hand Hand) Mask() (mask CardMask, err error) {
+	for _, card := range hand {
+		id := card.ID()
+		if id == NoCard {
+			err = fmt.Errorf("Mask:: invalid card %v", card)
+			return
+		}
+		if mask.Contains(id) {
+			err = fmt.Errorf("Mask:: %v is in the hand twice", card)
+			return
+		}
+		mask |= id.Mask()
+	}
+	return
+}
+
+// Contains returns whether the set holds a card
+func (mask CardMask) Contains(id CardID) bool {
+	return mask&id.Mask() != 0
+}
+
+// Len returns how many cards are in the set
+func (mask CardMask) Len() int {
+	return bits.OnesCount64(uint64(mask))
+}
+
+// IDs returns the cards in the set, in deck order
+func (mask CardMask) IDs() (ids []CardID) {
+	ids = []CardID{}
+	for ; mask != 0; mask &= mask - 1 {
+		ids = append(ids, CardID(bits.TrailingZeros64(uint64(mask))))
+	}
+	return
+}
+
+// Hand returns the cards in the set, in deck order
+func (mask CardMask) Hand() (hand Hand) {
+	hand = Hand{}
+	for _, id := range mask.IDs() {
+		hand = append(hand, id.Card())
+	}
+	return
+}
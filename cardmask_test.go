@@ -0,0 +1,43 @@
+package poner_test
+
+import (
+	"testing"
+
+	"github.com/blakecallens/poner"
+)
+
+func TestCardMask(t *testing.T) {
+	deck := poner.Deck{}.New()
+	for index, card := range deck.Cards {
+		id := card.ID()
+		if int(id) != index || id.Card() != card || id.Order() != card.Order {
+			t.Errorf("Error getting id of %v, got %v", card, id)
+		}
+	}
+	if id := (poner.Card{Name: "X"}).ID(); id != poner.NoCard {
+		t.Errorf("Error getting id of an invalid card, got %v", id)
+	}
+
+	hand, err := deck.PullCards("Kd 2c As 10h")
+	if err != nil {
+		t.Errorf("Error pulling cards from deck: %v", err)
+		return
+	}
+	mask, err := hand.Mask()
+	if err != nil || mask.Len() != 4 {
+		t.Errorf("Error getting mask of %v, got %b and %v", hand, mask, err)
+	}
+	for _, card := range hand {
+		if !mask.Contains(card.ID()) {
+			t.Errorf("Error getting mask of %v, missing %v", hand, card)
+		}
+	}
+	if sorted := mask.Hand(); len(sorted) != 4 || sorted[0] != hand[2] || sorted[3] != hand[0] {
+		t.Errorf("Error getting hand of mask, got %v", sorted)
+	}
+
+	_, err = append(hand, hand[0]).Mask()
+	if err == nil {
+		t.Error("Error getting mask, did not get err for a repeated card")
+	}
+}
@@ -44,6 +44,16 @@ func (hand Hand) RemoveCard(card Card) (newHand Hand) {
 	return hand
 }
 
+// Contains returns whether a card is in a hand
+func (hand Hand) Contains(card Card) bool {
+	for _, handCard := range hand {
+		if card == handCard {
+			return true
+		}
+	}
+	return false
+}
+
 // Frequency represents the number of cards of a type left in the deck
 type Frequency struct {
 	Name  string
@@ -56,11 +66,17 @@ type Frequency struct {
 type Deck struct {
 	Cards       []Card
 	Frequencies []Frequency
+	Random      *rand.Rand
 }
 
-// New returns a brand new deck of 52 cards
+// newRandom returns a random source seeded from the current time
+func newRandom() *rand.Rand {
+	return rand.New(rand.NewSource(time.Now().UnixNano()))
+}
+
+// New returns a brand new deck of 52 cards, keeping the deck's random source
 func (deck Deck) New() (newDeck Deck) {
-	newDeck = Deck{}
+	newDeck = Deck{Random: deck.Random}
 
 	for _, suit := range suits {
 		for ii, name := range names {
@@ -77,12 +93,30 @@ func (deck Deck) New() (newDeck Deck) {
 	return
 }
 
+// SetSource sets the random source used to shuffle and cut the deck
+func (deck *Deck) SetSource(source rand.Source) {
+	deck.Random = rand.New(source)
+}
+
+// SetSeed seeds the deck so shuffles and cuts can be reproduced
+func (deck *Deck) SetSeed(seed int64) {
+	deck.SetSource(rand.NewSource(seed))
+}
+
+// random returns the deck's random source, creating a time seeded one if needed
+func (deck *Deck) random() *rand.Rand {
+	if deck.Random == nil {
+		deck.Random = newRandom()
+	}
+	return deck.Random
+}
+
 // Shuffle shuffles the cards in a deck
 func (deck *Deck) Shuffle() {
+	random := deck.random()
 	shuffledCards := []Card{}
 	for len(deck.Cards) > 0 {
-		rand.Seed(time.Now().UnixNano())
-		index := rand.Intn(len(deck.Cards))
+		index := random.Intn(len(deck.Cards))
 		shuffledCards = append(shuffledCards, deck.Cards[index])
 		deck.Cards = append(deck.Cards[:index], deck.Cards[index+1:]...)
 	}
@@ -91,8 +125,7 @@ func (deck *Deck) Shuffle() {
 
 // Cut cuts the cards in a deck
 func (deck *Deck) Cut() {
-	rand.Seed(time.Now().UnixNano())
-	index := rand.Intn(len(deck.Cards))
+	index := deck.random().Intn(len(deck.Cards))
 	deck.Cards = append(deck.Cards[index:], deck.Cards[:index]...)
 }
 
@@ -118,11 +151,13 @@ func (deck *Deck) Deal(cards int, players int) (hands []Hand, err error) {
 
 // DealCribbage auto deals the correct amount of cribbage cards for players
 func (deck *Deck) DealCribbage(players int) (hands []Hand, err error) {
-	cards := 6
-	if players > 2 {
-		cards = 5
-	}
-	return deck.Deal(cards, players)
+	return deck.Deal(SixCardRules.Dealt(players), players)
+}
+
+// DealFiveCard deals five-card cribbage hands, five cards each for two
+// players and four for more
+func (deck *Deck) DealFiveCard(players int) (hands []Hand, err error) {
+	return deck.Deal(FiveCardRules.Dealt(players), players)
 }
 
 // PullFromTop deals one card from the deck
@@ -144,6 +144,27 @@ func TestDealCribbage(t *testing.T) {
 	}
 }
 
+func TestDealFiveCard(t *testing.T) {
+	for players, want := range map[int]int{2: 5, 3: 4, 4: 4} {
+		deck := poner.Deck{}.New()
+		deck.Shuffle()
+		hands, err := deck.DealFiveCard(players)
+		if err != nil {
+			t.Errorf("Error dealing cards from deck: %v", err)
+			return
+		}
+		if len(hands) != players {
+			t.Errorf("Error dealing cards from deck, got %v hands, want %v", len(hands), players)
+		}
+		for _, hand := range hands {
+			if len(hand) != want {
+				t.Errorf("Error dealing cards from deck, got %v cards, want %v", len(hand), want)
+				break
+			}
+		}
+	}
+}
+
 func TestPullFromTop(t *testing.T) {
 	deck := poner.Deck{}.New()
 	deck.Shuffle()
@@ -204,3 +225,20 @@ func TestPullCards(t *testing.T) {
 		t.Errorf("Error pulling cards from deck, got %v hands, want A♠", cards[4])
 	}
 }
+
+func TestSeededShuffle(t *testing.T) {
+	first := poner.Deck{}.New()
+	first.SetSeed(42)
+	first.Shuffle()
+	first.Cut()
+	second := poner.Deck{}.New()
+	second.SetSeed(42)
+	second.Shuffle()
+	second.Cut()
+	for ii := range first.Cards {
+		if first.Cards[ii] != second.Cards[ii] {
+			t.Errorf("Error shuffling seeded decks, got %v, want %v", second.Cards[ii], first.Cards[ii])
+			return
+		}
+	}
+}
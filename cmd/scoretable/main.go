@@ -0,0 +1,35 @@
+// Command scoretable scores every multiset of five ranks and writes the
+// resulting hand score table as Go source for the poner package
+package main
+
+import (
+	"flag"
+	"fmt"
+	"os"
+	"strings"
+
+	"github.com/blakecallens/poner"
+)
+
+func main() {
+	out := flag.String("out", "scoretable_data.go", "file to write the table to")
+	flag.Parse()
+
+	err := run(*out)
+	if err != nil {
+		fmt.Fprintln(os.Stderr, err)
+		os.Exit(1)
+	}
+}
+
+// run writes the table to the output file
+func run(out string) (err error) {
+	file, err := os.Create(out)
+	if err != nil {
+		return
+	}
+	defer file.Close()
+
+	command := strings.Join(append([]string{"scoretable"}, os.Args[1:]...), " ")
+	return poner.WriteScoreTable(file, command)
+}
@@ -0,0 +1,55 @@
+// Command wintable simulates two player games with the engine's game loop and
+// writes the resulting win probability table as Go source for the poner package
+package main
+
+import (
+	"flag"
+	"fmt"
+	"math/rand"
+	"os"
+	"strings"
+
+	"github.com/blakecallens/poner"
+)
+
+func main() {
+	variant := flag.String("variant", poner.SixCardRules.Name, "rules preset to simulate")
+	toWin := flag.Int("towin", 0, "points needed to win the game, defaults to the variant's")
+	rounds := flag.Int("rounds", 100000, "number of rounds to simulate")
+	seed := flag.Int64("seed", 1, "seed for the simulated games")
+	out := flag.String("out", "", "file to write the table to, defaults to winprob_table_<towin>.go")
+	flag.Parse()
+
+	rules, found := poner.PresetRules(*variant)
+	if !found {
+		fmt.Fprintf(os.Stderr, "unknown variant %q\n", *variant)
+		os.Exit(1)
+	}
+	if *toWin > 0 {
+		rules.ToWin = *toWin
+	}
+	if *out == "" {
+		*out = fmt.Sprintf("winprob_table_%v.go", rules.ToWin)
+	}
+	err := run(rules, *rounds, *seed, *out)
+	if err != nil {
+		fmt.Fprintln(os.Stderr, err)
+		os.Exit(1)
+	}
+}
+
+// run simulates the table and writes it to the output file
+func run(rules poner.Rules, rounds int, seed int64, out string) (err error) {
+	table, err := poner.SimulateWinTable(rules, rounds, rand.New(rand.NewSource(seed)))
+	if err != nil {
+		return
+	}
+	file, err := os.Create(out)
+	if err != nil {
+		return
+	}
+	defer file.Close()
+
+	command := strings.Join(append([]string{"wintable"}, os.Args[1:]...), " ")
+	return table.WriteSource(file, command)
+}
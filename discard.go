@@ -37,6 +37,10 @@ var opponentCribDiscards = [][]float32{
 	{4.30, 4.45, 4.48, 4.36, 7.25, 4.14, 4.27, 4.20, 4.03, 3.88, 4.77, 4.49, 5.65},
 }
 
+// Average crib by the rank of a single discarded card, with the rest of the
+// crib random, for three player games
+var singleCribDiscards = []float32{4.44, 4.67, 4.83, 4.83, 6.65, 4.79, 4.68, 4.63, 4.52, 4.45, 4.70, 4.28, 4.08}
+
 // Discard holds a player's held and discarded cards
 type Discard struct {
 	Held             Hand
@@ -53,75 +57,56 @@ func (discard Discard) String() string {
 
 // GetAverageScore returns the average score of a hand
 func (hand Hand) GetAverageScore(deck *Deck) float32 {
-	frequencyTotal := 0
-	for _, frequency := range deck.Frequencies {
-		_, total := hand.Score(Card{Name: frequency.Name, Value: frequency.Value, Order: frequency.Order}, false)
-		frequencyTotal += total * frequency.Count
-	}
-	return float32(frequencyTotal) / float32(46)
+	return SixCardRules.AverageScore(hand, deck)
 }
 
 // BuildPossibleDiscards returns all the different discard options for a hand
 func (hand Hand) BuildPossibleDiscards(deck *Deck, playersCrib bool) (discards []Discard) {
-	discards = []Discard{}
-	pairings := Pairings{}
-	// Quadruples
-	for ii := 0; ii < len(hand)-3; ii++ {
-		for jj := ii + 1; jj < len(hand)-2; jj++ {
-			for kk := jj + 1; kk < len(hand)-1; kk++ {
-				for ll := kk + 1; ll < len(hand); ll++ {
-					pairing := Hand{hand[ii], hand[jj], hand[kk], hand[ll]}
-					pairings = append(pairings, pairing)
-				}
-			}
-		}
-	}
-	// Quadruples matched to discards
-	for _, held := range pairings {
-		discarded := Hand{}
-		for _, handCard := range hand {
-			match := false
-			for _, card := range held {
-				if card == handCard {
-					match = true
-					break
-				}
-			}
-			if !match {
-				discarded = append(discarded, handCard)
-			}
-		}
-		discard := Discard{
-			Held:        held,
-			Discarded:   discarded,
-			Played:      Hand{},
-			HeldAverage: held.GetAverageScore(deck),
-		}
-		if len(discarded) == 2 {
-			if playersCrib {
-				discard.DiscardedAverage = playerCribDiscards[discarded[0].Order][discarded[1].Order]
-			} else {
-				discard.DiscardedAverage = opponentCribDiscards[discarded[0].Order][discarded[1].Order]
-			}
-		}
+	return hand.BuildPossibleHolds(4, deck, playersCrib)
+}
 
-		discards = append(discards, discard)
-	}
-	return
+// BuildPossibleHolds returns all the different discard options for a hand
+// that keep size cards
+func (hand Hand) BuildPossibleHolds(size int, deck *Deck, playersCrib bool) (discards []Discard) {
+	rules := SixCardRules
+	rules.HandSize = size
+	return rules.BuildPossibleHolds(hand, deck, playersCrib)
+}
+
+// BuildDiscard builds a discard with the average scores of its held and discarded cards
+func BuildDiscard(held Hand, discarded Hand, deck *Deck, playersCrib bool) (discard Discard) {
+	return SixCardRules.BuildDiscard(held, discarded, deck, playersCrib)
 }
 
 // GetDiscards returns the possible discards for a hand sorted by best first
 func (hand Hand) GetDiscards(deck *Deck, playersCrib bool) (discards []Discard) {
-	discards = hand.BuildPossibleDiscards(deck, playersCrib)
-	sort.Slice(discards, func(ii, jj int) bool {
+	return hand.GetHolds(4, deck, playersCrib)
+}
+
+// GetHolds returns the possible discards for a hand that keep size cards,
+// sorted by best first
+func (hand Hand) GetHolds(size int, deck *Deck, playersCrib bool) (discards []Discard) {
+	discards = hand.BuildPossibleHolds(size, deck, playersCrib)
+	sortDiscards(discards, playersCrib, false)
+	return
+}
+
+// sortDiscards sorts discards by best first, adding the crib average to the
+// held average for the player's own crib and subtracting it otherwise. For
+// lowball the best discard is the one worth the least
+func sortDiscards(discards []Discard, playersCrib bool, lowball bool) {
+	value := func(discard Discard) float32 {
 		if playersCrib {
-			return discards[ii].HeldAverage+discards[ii].DiscardedAverage >
-				discards[jj].HeldAverage+discards[jj].DiscardedAverage
+			return discard.HeldAverage + discard.DiscardedAverage
 		}
-		return discards[ii].HeldAverage-discards[ii].DiscardedAverage >
-			discards[jj].HeldAverage-discards[jj].DiscardedAverage
+		return discard.HeldAverage - discard.DiscardedAverage
+	}
+	sort.Slice(discards, func(ii, jj int) bool {
+		if lowball {
+			return value(discards[ii]) < value(discards[jj])
+		}
+		return value(discards[ii]) > value(discards[jj])
 	})
-	return
 }
 
 // GetBestDiscard returns the best possible discard for a hand
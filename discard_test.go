@@ -31,8 +31,8 @@ func TestGetAverageScore(t *testing.T) {
 		return
 	}
 	avg := hand[:4].GetAverageScore(&deck)
-	if avg != 11.565217 {
-		t.Errorf("Error getting average score, got %v, want 11.565217", avg)
+	if avg != 11.086957 {
+		t.Errorf("Error getting average score, got %v, want 11.086957", avg)
 	}
 }
 
@@ -70,3 +70,54 @@ func TestGetBestDiscard(t *testing.T) {
 		t.Errorf("Error getting average score, got %v, want 12.478261", discard.HeldAverage)
 	}
 }
+
+func TestSingleCardDiscard(t *testing.T) {
+	deck := poner.Deck{}.New()
+	deck.Shuffle()
+	hand, err := deck.PullCards("5c 5d Jh 10s Kc")
+	if err != nil {
+		t.Errorf("Error pulling cards from deck: %v", err)
+		return
+	}
+	discards := hand.GetDiscards(&deck, true)
+	if len(discards) != 5 {
+		t.Errorf("Error getting possible discards, got %v discards, want 5", len(discards))
+	}
+	averages := map[string]float32{}
+	for _, discard := range discards {
+		if len(discard.Discarded) != 1 || discard.DiscardedAverage == 0 {
+			t.Errorf("Error evaluating single card discard %v", discard)
+			return
+		}
+		averages[discard.Discarded[0].Name] = discard.DiscardedAverage
+	}
+	if averages["5"] <= averages["J"] || averages["J"] <= averages["K"] {
+		t.Errorf("Error evaluating single card discards, got %v, want 5 over J over K", averages)
+	}
+}
+
+func TestBuildPossibleHolds(t *testing.T) {
+	deck := poner.Deck{}.New()
+	deck.Shuffle()
+	hand, err := deck.PullCards("2c 3c 5c Jc 4d")
+	if err != nil {
+		t.Errorf("Error pulling cards from deck: %v", err)
+		return
+	}
+	discards := hand.GetHolds(3, &deck, false)
+	if len(discards) != 10 {
+		t.Errorf("Error getting possible holds, got %v discards, want 10", len(discards))
+	}
+	for _, discard := range discards {
+		if len(discard.Held) != 3 || len(discard.Discarded) != 2 {
+			t.Errorf("Error building hold, got %v", discard)
+			return
+		}
+	}
+	if discards[0].HeldAverage-discards[0].DiscardedAverage < discards[1].HeldAverage-discards[1].DiscardedAverage {
+		t.Error("Error sorting holds, best hold not first")
+	}
+	if len(hand.BuildPossibleHolds(6, &deck, false)) != 0 {
+		t.Error("Error building holds, got holds larger than the hand")
+	}
+}
@@ -0,0 +1,238 @@
+package poner
+
+import (
+	"math"
+	"sort"
+)
+
+// defaultEndgameDistance is how close to winning either player has to be before
+// an EndgameStrategy stops playing for points and starts playing to win
+const defaultEndgameDistance = 30
+
+// EndgameStrategy plays the default strategy until either player of a two
+// player game is within Distance points of winning, then picks the discards
+// and plays with the best chance of winning the game. A nil WinProbability
+// uses the package's WinProbability
+type EndgameStrategy struct {
+	DefaultStrategy
+	Distance       int
+	WinProbability WinProbabilityFunc
+}
+
+// ChooseDiscard picks the discard with the best chance of winning near the end of the game
+func (strategy EndgameStrategy) ChooseDiscard(view PlayerView) Discard {
+	if view.rules().Lowball || !strategy.inEndgame(view.Scores, view.ToWin) {
+		return strategy.DefaultStrategy.ChooseDiscard(view)
+	}
+
+	deck := view.UnseenDeck()
+	discards := view.Hand.BuildPossibleHolds(view.rules().HandSize, &deck, view.IsDealer())
+	chances := make([]float64, len(discards))
+	for ii, discard := range discards {
+		chances[ii] = strategy.discardChance(view, discard, deck.Cards)
+	}
+	indexes := rankedIndexes(chances)
+	return discards[indexes[skillAdjust(view.Random, view.SkillLevel, len(indexes))]]
+}
+
+// ChoosePlay picks the play with the best chance of winning near the end of the game
+func (strategy EndgameStrategy) ChoosePlay(view PlayerView) (card Card, cantPlay bool) {
+	if view.rules().Lowball || !strategy.inEndgame(view.Scores, view.ToWin) {
+		return strategy.DefaultStrategy.ChoosePlay(view)
+	}
+
+	plays, cantPlay := view.rules().GetPlays(view.Hand, view.Field, view.NextPlayer())
+	if cantPlay {
+		return
+	}
+	chances := make([]float64, len(plays))
+	for ii, play := range plays {
+		chances[ii] = strategy.playChance(view, play.Card)
+	}
+	indexes := rankedIndexes(chances)
+	card = plays[indexes[skillAdjust(view.Random, view.SkillLevel, len(indexes))]].Card
+	return
+}
+
+// inEndgame returns whether either player of a two player game is close enough to winning
+func (strategy EndgameStrategy) inEndgame(scores []int, toWin int) bool {
+	if len(scores) != 2 {
+		return false
+	}
+	distance := strategy.Distance
+	if distance == 0 {
+		distance = defaultEndgameDistance
+	}
+	return scores[0] >= toWin-distance || scores[1] >= toWin-distance
+}
+
+// winProbability returns the strategy's win probability model
+func (strategy EndgameStrategy) winProbability() WinProbabilityFunc {
+	if strategy.WinProbability == nil {
+		return WinProbability
+	}
+	return strategy.WinProbability
+}
+
+// discardChance returns the chance of winning after holding a discard, from
+// the held hand's score with every possible starter, the expected crib, his
+// heels and the average pegging and hand of the opponent, counted in order
+func (strategy EndgameStrategy) discardChance(view PlayerView, discard Discard, unseen []Card) float64 {
+	held := make([]float64, maxDistributedScore+1)
+	heels := []float64{1}
+	if value := view.rules().HisHeels; value > 0 {
+		heels = make([]float64, value+1)
+	}
+	for _, starter := range unseen {
+		total := view.rules().ScoreTotal(discard.Held, starter, false)
+		if total > maxDistributedScore {
+			total = maxDistributedScore
+		}
+		held[total] += 1 / float64(len(unseen))
+		heels[view.rules().HisHeelsScore(starter).Value] += 1 / float64(len(unseen))
+	}
+	crib := normalDistribution(float64(discard.DiscardedAverage), cribSpread)
+
+	rest := roundRest{
+		heels:      heels,
+		ponePegs:   ponePegsDistribution,
+		dealerPegs: dealerPegsDistribution,
+		poneShow:   held,
+		dealerShow: convolve(handDistribution, crib),
+	}
+	if view.IsDealer() {
+		rest.poneShow, rest.dealerShow = handDistribution, convolve(held, crib)
+	}
+	return strategy.roundChance(view.Scores[view.Index], view.Scores[1-view.Index], view.IsDealer(), view.ToWin, rest)
+}
+
+// playChance returns the chance of winning after playing a card, from the
+// points it pegs, the best reply the opponent could be holding, the rest of
+// the pegging and the hands still to be counted
+func (strategy EndgameStrategy) playChance(view PlayerView, card Card) float64 {
+	myScore, opponentScore := view.Scores[view.Index], view.Scores[1-view.Index]
+	field := append(copyHand(view.Field), card)
+	for _, score := range view.rules().FieldScore(field) {
+		myScore += score.Value
+	}
+	if myScore >= view.ToWin {
+		return 1
+	}
+
+	isDealer := view.IsDealer()
+	held := view.rules().ScoreTotal(append(copyHand(view.Hand), view.Played...), view.Starter, false)
+	mine := make([]float64, held+1)
+	mine[held] = 1
+	rest := roundRest{heels: []float64{1}, poneShow: mine, dealerShow: convolve(handDistribution, cribDistribution)}
+	myCards, theirCards := len(view.Hand)-1, view.NextPlayer().Cards-1
+	rest.ponePegs = pegsLeft(averagePonePegs, ponePegsSpread, myCards, view.rules().HandSize)
+	rest.dealerPegs = pegsLeft(averageDealerPegs, dealerPegsSpread, theirCards, view.rules().HandSize)
+	if isDealer {
+		rest.poneShow, rest.dealerShow = handDistribution, convolve(mine, cribDistribution)
+		rest.ponePegs = pegsLeft(averagePonePegs, ponePegsSpread, theirCards, view.rules().HandSize)
+		rest.dealerPegs = pegsLeft(averageDealerPegs, dealerPegsSpread, myCards, view.rules().HandSize)
+	}
+
+	chance := 0.0
+	for points, replyChance := range view.replyDistribution(field) {
+		if replyChance > 0 {
+			chance += replyChance * strategy.roundChance(myScore, opponentScore+points, isDealer, view.ToWin, rest)
+		}
+	}
+	return chance
+}
+
+// pegsLeft returns the chances of the points a player pegs with some of
+// their cards still to play, scaling a whole hand's pegging by the cards left
+func pegsLeft(average float64, spread float64, cards int, handSize int) []float64 {
+	if cards <= 0 || handSize <= 0 {
+		return []float64{1}
+	}
+	if cards > handSize {
+		cards = handSize
+	}
+	share := float64(cards) / float64(handSize)
+	return normalDistribution(average*share, spread*math.Sqrt(share))
+}
+
+// replyDistribution returns the chances of the next player's best reply to a
+// field scoring each number of points, if they hold the best unseen cards
+// they could
+func (view PlayerView) replyDistribution(field Hand) (distribution []float64) {
+	nextPlayer := view.NextPlayer()
+	cards := nextPlayer.Cards
+	if nextPlayer.Gone || cards == 0 || field.GetTotal() == view.rules().PegLimit {
+		return []float64{1}
+	}
+
+	unseen := view.Unseen()
+	if cards > len(unseen) {
+		cards = len(unseen)
+	}
+	replies := []int{}
+	for _, card := range unseen {
+		_, scores, err := view.rules().Play(copyHand(field), card)
+		if err != nil {
+			continue
+		}
+		points := 0
+		for _, score := range scores {
+			points += score.Value
+		}
+		if points > 0 {
+			replies = append(replies, points)
+		}
+	}
+	sort.Sort(sort.Reverse(sort.IntSlice(replies)))
+
+	// The chance the best reply is worth at least the points of the ii'th best unseen card
+	distribution = make([]float64, 1)
+	atLeast := 0.0
+	for ii, points := range replies {
+		if ii+1 < len(replies) && replies[ii+1] == points {
+			continue
+		}
+		for len(distribution) <= points {
+			distribution = append(distribution, 0)
+		}
+		chance := 1 - combinations(len(unseen)-ii-1, cards)/combinations(len(unseen), cards)
+		distribution[points] = chance - atLeast
+		atLeast = chance
+	}
+	distribution[0] = 1 - atLeast
+	return
+}
+
+// roundChance returns the chance of winning once the rest of a round's
+// points are counted, in the order they are scored
+func (strategy EndgameStrategy) roundChance(myScore int, opponentScore int, isDealer bool, toWin int,
+	rest roundRest) float64 {
+	winProbability := strategy.winProbability()
+	if myScore >= toWin {
+		return 1
+	}
+	if opponentScore >= toWin {
+		return 0
+	}
+
+	if !isDealer {
+		return rest.chance(toWin-myScore, toWin-opponentScore, func(pone int, dealer int) float64 {
+			return winProbability(myScore+pone, opponentScore+dealer, true, toWin)
+		})
+	}
+	return 1 - rest.chance(toWin-opponentScore, toWin-myScore, func(pone int, dealer int) float64 {
+		return 1 - winProbability(myScore+dealer, opponentScore+pone, false, toWin)
+	})
+}
+
+// rankedIndexes returns indexes sorted by the highest chance first, keeping the original order for ties
+func rankedIndexes(chances []float64) (indexes []int) {
+	indexes = make([]int, len(chances))
+	for ii := range indexes {
+		indexes[ii] = ii
+	}
+	sort.SliceStable(indexes, func(ii, jj int) bool {
+		return chances[indexes[ii]] > chances[indexes[jj]]
+	})
+	return
+}
@@ -0,0 +1,159 @@
+package poner_test
+
+import (
+	"testing"
+
+	"github.com/blakecallens/poner"
+)
+
+func TestEndgamePlay(t *testing.T) {
+	deck := poner.Deck{}.New()
+	field, err := deck.PullCards("Kc Ac 5d")
+	if err != nil {
+		t.Errorf("Error pulling cards from deck: %v", err)
+		return
+	}
+	hand, err := deck.PullCards("5s 9s")
+	if err != nil {
+		t.Errorf("Error pulling cards from deck: %v", err)
+		return
+	}
+	played, err := deck.PullCards("2s 7h")
+	if err != nil {
+		t.Errorf("Error pulling cards from deck: %v", err)
+		return
+	}
+	nextPlayed, err := deck.PullCards("5h")
+	if err != nil {
+		t.Errorf("Error pulling cards from deck: %v", err)
+		return
+	}
+	starter, err := deck.PullFromTop()
+	if err != nil {
+		t.Errorf("Error pulling cards from deck: %v", err)
+		return
+	}
+	view := poner.PlayerView{
+		Hand:    hand,
+		Dealt:   append(poner.Hand{}, append(hand, played...)...),
+		Played:  played,
+		Field:   field,
+		Starter: starter,
+		Players: []poner.PublicPlayer{
+			{Cards: 2, Played: played},
+			{Cards: 3, Played: nextPlayed},
+		},
+		Dealer:     0,
+		Scores:     []int{119, 115},
+		ToWin:      121,
+		SkillLevel: 4,
+	}
+
+	// The default strategy avoids pairing a five the opponent has shown
+	card, _ := poner.DefaultStrategy{}.ChoosePlay(view)
+	if card != hand[1] {
+		t.Errorf("Error choosing default play, got %v, want %v", card, hand[1])
+	}
+	// The dealer pegging two for the pair wins before the pone can count
+	card, cantPlay := poner.EndgameStrategy{}.ChoosePlay(view)
+	if cantPlay || card != hand[0] {
+		t.Errorf("Error choosing endgame play, got %v, want %v", card, hand[0])
+	}
+
+	// Far from the end it plays like the default strategy
+	view.Scores = []int{40, 30}
+	card, _ = poner.EndgameStrategy{}.ChoosePlay(view)
+	if card != hand[1] {
+		t.Errorf("Error choosing early play, got %v, want %v", card, hand[1])
+	}
+}
+
+func TestEndgameDiscard(t *testing.T) {
+	deck := poner.Deck{}.New()
+	hand, err := deck.PullCards("5c 5d Jh 10s 2c 9d")
+	if err != nil {
+		t.Errorf("Error pulling cards from deck: %v", err)
+		return
+	}
+	view := poner.PlayerView{
+		Hand:       hand,
+		Dealt:      hand,
+		Dealer:     0,
+		Players:    []poner.PublicPlayer{{Score: 110}, {Score: 116}},
+		Scores:     []int{110, 116},
+		ToWin:      121,
+		SkillLevel: 4,
+	}
+	discard := poner.EndgameStrategy{}.ChooseDiscard(view)
+	if len(discard.Held) != 4 || len(discard.Discarded) != 2 {
+		t.Errorf("Error choosing endgame discard, got %v", discard)
+	}
+	for _, card := range append(discard.Held, discard.Discarded...) {
+		if !hand.Contains(card) {
+			t.Errorf("Error choosing endgame discard, %v was not dealt", card)
+		}
+	}
+}
+
+func TestEndgameStrategy(t *testing.T) {
+	players := []poner.Player{
+		{Name: "Bob", IsComputer: true, SkillLevel: 4, Strategy: poner.EndgameStrategy{}},
+		{Name: "Sue", IsComputer: true, SkillLevel: 4},
+	}
+	game := poner.Game{}
+	game.SetSeed(11)
+	game.New(players)
+	playUntilOver(t, &game)
+}
+
+func TestEndgamePeggingFinish(t *testing.T) {
+	deck := poner.Deck{}.New()
+	hand, err := deck.PullCards("2h 9h")
+	if err != nil {
+		t.Errorf("Error pulling cards from deck: %v", err)
+		return
+	}
+	played, err := deck.PullCards("Kd 8s")
+	if err != nil {
+		t.Errorf("Error pulling cards from deck: %v", err)
+		return
+	}
+	discarded, err := deck.PullCards("9c 2c")
+	if err != nil {
+		t.Errorf("Error pulling cards from deck: %v", err)
+		return
+	}
+	nextPlayed, err := deck.PullCards("9s 5c")
+	if err != nil {
+		t.Errorf("Error pulling cards from deck: %v", err)
+		return
+	}
+	starter, err := deck.PullCard("5", "h")
+	if err != nil {
+		t.Errorf("Error pulling card from deck: %v", err)
+		return
+	}
+	view := poner.PlayerView{
+		Hand:    hand,
+		Dealt:   append(append(append(poner.Hand{}, hand...), played...), discarded...),
+		Played:  played,
+		Field:   poner.Hand{played[1], nextPlayed[1]},
+		Starter: starter,
+		Players: []poner.PublicPlayer{
+			{Cards: 2, Played: played},
+			{Cards: 2, Played: nextPlayed},
+		},
+		Dealer:     1,
+		Scores:     []int{118, 119},
+		ToWin:      121,
+		SkillLevel: 4,
+	}
+
+	// The pone's hand counts four, but the dealer can peg the two they need
+	// before it is counted. Fifteen for two leaves the pone needing one, and
+	// is worth a slightly bigger chance of the dealer pairing the two
+	card, cantPlay := poner.EndgameStrategy{}.ChoosePlay(view)
+	if cantPlay || card != hand[0] {
+		t.Errorf("Error choosing endgame play, got %v, want %v", card, hand[0])
+	}
+}
@@ -0,0 +1,228 @@
+package poner
+
+import (
+	"fmt"
+)
+
+// EventType is the kind of state transition recorded in a game's event log
+type EventType int
+
+// The different types of events
+const (
+	EventNewGame EventType = iota
+	EventDeal
+	EventDiscard
+	EventStarter
+	EventHisHeels
+	EventPlay
+	EventGo
+	EventGoScore
+	EventResetField
+	EventShow
+	EventCrib
+	EventMuggins
+	EventThreeForLast
+	EventMissed
+)
+
+var eventNames = [...]string{"New Game", "Deal", "Discard", "Starter", "His Heels", "Play", "Go", "Go Score",
+	"Reset Field", "Show", "Crib", "Muggins", "Three for Last", "Missed"}
+
+func (eventType EventType) String() string {
+	if eventType < 0 || int(eventType) >= len(eventNames) {
+		return fmt.Sprintf("Event(%d)", int(eventType))
+	}
+	return eventNames[eventType]
+}
+
+// Event is a single state transition of a game
+type Event struct {
+	Type      EventType `json:"type"`
+	Round     int       `json:"round"`
+	Player    int       `json:"player"`
+	Card      Card      `json:"card"`
+	Cards     Hand      `json:"cards,omitempty"`
+	Hands     []Hand    `json:"hands,omitempty"`
+	Scores    []Score   `json:"scores,omitempty"`
+	Total     int       `json:"total"`
+	Players   []Player  `json:"players,omitempty"`
+	ToWin     int       `json:"toWin,omitempty"`
+	Dealer    int       `json:"dealer"`
+	Muggins   bool      `json:"muggins,omitempty"`
+	OverClaim OverClaim `json:"overClaim,omitempty"`
+	Teams     bool      `json:"teams,omitempty"`
+	Rules     *Rules    `json:"rules,omitempty"`
+}
+
+func (event Event) String() string {
+	switch event.Type {
+	case EventNewGame:
+		return fmt.Sprintf("%v: %v players to %v", event.Type, len(event.Players), event.ToWin)
+	case EventDeal:
+		return fmt.Sprintf("%v: round %v, dealer %v", event.Type, event.Round, event.Dealer)
+	case EventStarter, EventPlay:
+		return fmt.Sprintf("%v: player %v %v %v", event.Type, event.Player, event.Card, event.Scores)
+	default:
+		return fmt.Sprintf("%v: player %v %v %v", event.Type, event.Player, event.Cards, event.Scores)
+	}
+}
+
+// record appends an event to the game's log
+func (game *Game) record(event Event) {
+	event.Round = game.Round
+	game.Events = append(game.Events, event)
+}
+
+// playerIndex returns the index of a player in the game, or -1 if not found
+func (game *Game) playerIndex(player *Player) int {
+	for ii := range game.Players {
+		if &game.Players[ii] == player {
+			return ii
+		}
+	}
+	return -1
+}
+
+// copyHand returns a copy of a hand that doesn't share memory with the original
+func copyHand(hand Hand) Hand {
+	return append(Hand{}, hand...)
+}
+
+// Replay rebuilds a game from the first index events of a log
+func Replay(events []Event, index int) (game Game, err error) {
+	if index < 0 || index > len(events) {
+		err = fmt.Errorf("Replay:: index %v out of range for %v events", index, len(events))
+		return
+	}
+	for ii, event := range events[:index] {
+		if ii > 0 && event.Type == EventNewGame {
+			err = fmt.Errorf("Replay:: unexpected %v event at %v", event.Type, ii)
+			return
+		}
+		err = game.applyEvent(event)
+		if err != nil {
+			return
+		}
+	}
+	game.Events = append([]Event{}, events[:index]...)
+	return
+}
+
+// applyEvent applies a logged event to the game's state
+func (game *Game) applyEvent(event Event) (err error) {
+	if event.Type == EventNewGame {
+		game.Players = []Player{}
+		for _, player := range event.Players {
+			game.Players = append(game.Players, player)
+		}
+		game.ToWin = event.ToWin
+		game.Dealer = event.Dealer
+		game.Muggins = event.Muggins
+		game.OverClaim = event.OverClaim
+		game.Missed = nil
+		game.Teams = event.Teams
+		game.Rules = Rules{}
+		if event.Rules != nil {
+			game.Rules = *event.Rules
+		}
+		game.Round = 0
+		game.Winner = nil
+		game.Phase = PhaseDeal
+		return
+	}
+	if event.Player < 0 || event.Player >= len(game.Players) {
+		err = fmt.Errorf("Replay:: %v event has invalid player %v", event.Type, event.Player)
+		return
+	}
+
+	// Missed points are recorded after the action that missed them, and
+	// can only be taken before the next one
+	if event.Type != EventMissed {
+		game.Missed = nil
+	}
+
+	player := &game.Players[event.Player]
+	switch event.Type {
+	case EventDeal:
+		if len(event.Hands) != len(game.Players) {
+			err = fmt.Errorf("Replay:: deal of %v hands for %v players", len(event.Hands), len(game.Players))
+			return
+		}
+		game.Round = event.Round
+		game.Dealer = event.Dealer
+		game.ActivePlayer = event.Dealer
+		game.Field = Hand{}
+		game.Crib = Hand{}
+		if event.Card != (Card{}) {
+			game.Crib = Hand{event.Card}
+		}
+		game.Starter = Card{}
+		game.Deck = Deck{Cards: copyHand(event.Cards), Random: game.Deck.Random}
+		game.Deck.GetFrequencies()
+		for ii := range game.Players {
+			game.Players[ii].DealtHand = copyHand(event.Hands[ii])
+			game.Players[ii].Discard = Discard{}
+			game.Players[ii].PlayingHand = Hand{}
+			game.Players[ii].Gone = false
+		}
+		game.Phase = PhaseDiscard
+	case EventDiscard:
+		held := copyHand(player.DealtHand)
+		for _, card := range event.Cards {
+			held = held.RemoveCard(card)
+		}
+		player.SetDiscard(Discard{Held: held, Discarded: copyHand(event.Cards), Played: Hand{}})
+	case EventStarter:
+		game.Starter = event.Card
+		game.Crib = copyHand(event.Cards)
+		game.Deck.Cards = Hand(game.Deck.Cards).RemoveCard(event.Card)
+		for _, card := range event.Cards {
+			game.Deck.Cards = Hand(game.Deck.Cards).RemoveCard(card)
+		}
+		game.Deck.GetFrequencies()
+		game.Phase = PhasePegging
+		game.ActivePlayer = game.nextIndex(game.Dealer)
+		game.LastPlayer = game.Dealer
+	case EventPlay:
+		game.Field = append(game.Field, event.Card)
+		game.ActivePlayer = event.Player
+		game.LastPlayer = event.Player
+		player.PlayingHand = player.PlayingHand.RemoveCard(event.Card)
+		player.Discard.Played = append(player.Discard.Played, event.Card)
+		game.addScore(player, event.Scores)
+		game.CheckForWinner(player)
+		game.passTurn()
+	case EventGo:
+		game.ActivePlayer = event.Player
+		player.Gone = true
+		game.passTurn()
+	case EventResetField:
+		game.ResetField()
+		game.ActivePlayer = game.LastPlayer
+		if game.AllPlaysDone() || !game.passTurn() {
+			game.Phase = PhaseShow
+			game.ActivePlayer = game.nextIndex(game.Dealer)
+		}
+	case EventShow:
+		game.addScore(player, event.Scores)
+		game.Phase = PhaseShow
+		game.ActivePlayer = game.nextIndex(event.Player)
+		if event.Player == game.Dealer {
+			game.Phase = PhaseCrib
+			game.ActivePlayer = game.Dealer
+		}
+		game.CheckForWinner(player)
+	case EventCrib:
+		game.addScore(player, event.Scores)
+		game.Phase = PhaseDeal
+		game.CheckForWinner(player)
+	case EventHisHeels, EventGoScore, EventMuggins, EventThreeForLast:
+		game.addScore(player, event.Scores)
+		game.CheckForWinner(player)
+	case EventMissed:
+		game.Missed = &MissedScore{Player: event.Player, Points: event.Total, Scores: append([]Score{}, event.Scores...)}
+	default:
+		err = fmt.Errorf("Replay:: unknown event type %v", event.Type)
+	}
+	return
+}
@@ -0,0 +1,75 @@
+package poner_test
+
+import (
+	"testing"
+
+	"github.com/blakecallens/poner"
+)
+
+func TestEventString(t *testing.T) {
+	if poner.EventHisHeels.String() != "His Heels" {
+		t.Errorf("Error stringing event type, got %v, want His Heels", poner.EventHisHeels)
+	}
+	if poner.EventType(99).String() != "Event(99)" {
+		t.Errorf("Error stringing event type, got %v, want Event(99)", poner.EventType(99))
+	}
+}
+
+func TestReplay(t *testing.T) {
+	players := []poner.Player{
+		{Name: "Bob", IsComputer: true, SkillLevel: 4},
+		{Name: "Sue", IsComputer: true, SkillLevel: 3},
+	}
+	game := poner.Game{}
+	game.New(players)
+	for game.Winner == nil {
+		err := playRound(&game)
+		if err != nil {
+			t.Errorf("Error simulating game: %v", err)
+			return
+		}
+		if game.Winner != nil {
+			break
+		}
+		scorePlayerHands(&game)
+	}
+
+	replayed, err := poner.Replay(game.Events, len(game.Events))
+	if err != nil {
+		t.Errorf("Error replaying game: %v", err)
+		return
+	}
+	for ii, player := range replayed.Players {
+		if player.Score != game.Players[ii].Score {
+			t.Errorf("Error replaying game, got score %v, want %v", player.Score, game.Players[ii].Score)
+		}
+	}
+	if replayed.Winner == nil || replayed.Winner.Name != game.Winner.Name {
+		t.Errorf("Error replaying game, got winner %v, want %v", replayed.Winner, game.Winner.Name)
+	}
+
+	// Step back to the first deal
+	for ii, event := range game.Events {
+		if event.Type != poner.EventStarter {
+			continue
+		}
+		replayed, err = poner.Replay(game.Events, ii+1)
+		if err != nil {
+			t.Errorf("Error replaying game: %v", err)
+			return
+		}
+		if replayed.Round != 1 || replayed.Starter != event.Card {
+			t.Errorf("Error replaying game, got round %v starter %v, want 1 %v", replayed.Round,
+				replayed.Starter, event.Card)
+		}
+		if len(replayed.Players[0].PlayingHand) != 4 {
+			t.Errorf("Error replaying game, got %v cards in hand, want 4", len(replayed.Players[0].PlayingHand))
+		}
+		break
+	}
+
+	_, err = poner.Replay(game.Events, len(game.Events)+1)
+	if err == nil {
+		t.Error("Error replaying game, did not get err for bad index")
+	}
+}
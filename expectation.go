@@ -0,0 +1,207 @@
+package poner
+
+import (
+	"math/rand"
+)
+
+// ExpectedScore returns the exact average score of a hand over every starter
+// that could be cut from the unseen cards, counting flushes and nobs
+func (hand Hand) ExpectedScore(unseen Hand) float64 {
+	return SixCardRules.ExpectedScore(hand, unseen)
+}
+
+// ExpectedScore returns the exact average score of a hand over every starter
+// that could be cut from the unseen cards
+func (rules Rules) ExpectedScore(hand Hand, unseen Hand) float64 {
+	if len(hand) < 3 || len(hand) > 5 || len(unseen) == 0 {
+		return 0
+	}
+	total := 0
+	for _, starter := range unseen {
+		total += rules.ScoreTotal(hand, starter, false)
+	}
+	return float64(total) / float64(len(unseen))
+}
+
+// CribExpectation returns the exact average score of a crib holding the
+// discarded cards, enumerating every way the rest of the crib and the starter
+// could come from the unseen cards
+func (discarded Hand) CribExpectation(unseen Hand) float64 {
+	return SixCardRules.CribExpectation(discarded, unseen)
+}
+
+// CribExpectation returns the exact average score of a crib holding the
+// discarded cards, enumerating every way the rest of the crib and the starter
+// could come from the unseen cards
+func (rules Rules) CribExpectation(discarded Hand, unseen Hand) float64 {
+	needed := rules.CribSize + 1 - len(discarded)
+	mask, err := discarded.Mask()
+	if needed < 1 || len(unseen) < needed || err != nil {
+		return 0
+	}
+	ids := make([]CardID, 0, len(unseen))
+	for _, card := range unseen {
+		if id := card.ID(); id != NoCard && !mask.Contains(id) {
+			ids = append(ids, id)
+		}
+	}
+	if len(ids) < needed {
+		return 0
+	}
+	// Each set of drawn cards is scored once, averaged over the cards that
+	// could have been the starter
+	total, count := 0.0, 0
+	drawn := make([]CardID, needed)
+	forEachCombination(len(ids), needed, func(indexes []int) {
+		for ii, index := range indexes {
+			drawn[ii] = ids[index]
+		}
+		total += rules.cribAverage(mask, drawn)
+		count++
+	})
+	return total / float64(count)
+}
+
+// SampleCribExpectation estimates the average score of a crib holding the
+// discarded cards from random draws of the rest of the crib and the starter
+func (discarded Hand) SampleCribExpectation(unseen Hand, samples int, random *rand.Rand) float64 {
+	return SixCardRules.SampleCribExpectation(discarded, unseen, samples, random)
+}
+
+// SampleCribExpectation estimates the average score of a crib holding the
+// discarded cards from random draws of the rest of the crib and the starter
+func (rules Rules) SampleCribExpectation(discarded Hand, unseen Hand, samples int, random *rand.Rand) float64 {
+	needed := rules.CribSize + 1 - len(discarded)
+	if needed < 1 || len(unseen) < needed || samples < 1 {
+		return 0
+	}
+	if random == nil {
+		random = newRandom()
+	}
+	cards := copyHand(unseen)
+	total := 0
+	for sample := 0; sample < samples; sample++ {
+		for ii := 0; ii < needed; ii++ {
+			jj := ii + random.Intn(len(cards)-ii)
+			cards[ii], cards[jj] = cards[jj], cards[ii]
+		}
+		crib := append(copyHand(discarded), cards[:needed-1]...)
+		total += rules.ScoreTotal(crib, cards[needed-1], true)
+	}
+	return float64(total) / float64(samples)
+}
+
+// cribAverage scores a crib from the discarded and drawn cards, averaging
+// over each drawn card being the starter
+func (rules Rules) cribAverage(discarded CardMask, drawn []CardID) float64 {
+	if !rules.CribFlushStarter || rules.Nineteen > 0 {
+		total := 0
+		for ii, starter := range drawn {
+			crib := discarded
+			for jj, card := range drawn {
+				if jj != ii {
+					crib |= card.Mask()
+				}
+			}
+			total += rules.maskScore(crib, starter.Order(), starter.Suit(), true)
+		}
+		return float64(total) / float64(len(drawn))
+	}
+
+	// When the crib only scores flushes with the starter, every card but the
+	// starter is interchangeable except for nobs. The cards are scored once
+	// and nobs is averaged over the cards that could have been the starter
+	starter := drawn[len(drawn)-1]
+	crib := discarded
+	for _, card := range drawn[:len(drawn)-1] {
+		crib |= card.Mask()
+	}
+	score := rules.maskScore(crib, starter.Order(), starter.Suit(), true)
+	nobsValue := rules.value(nobs, rules.Nobs).Value
+	if crib.Contains(nobsJack(starter)) {
+		score -= nobsValue
+	}
+	average := float64(score)
+
+	cards := crib | starter.Mask()
+	for _, starter := range drawn {
+		if jack := nobsJack(starter); jack != starter && cards.Contains(jack) {
+			average += float64(nobsValue) / float64(len(drawn))
+		}
+	}
+	return average
+}
+
+// nobsJack returns the jack of the starter's suit
+func nobsJack(starter CardID) CardID {
+	return CardID(starter.Suit()*13 + 10)
+}
+
+// forEachCombination calls fn with the indexes of every k sized combination of n items
+func forEachCombination(n int, k int, fn func(indexes []int)) {
+	indexes := make([]int, k)
+	for ii := range indexes {
+		indexes[ii] = ii
+	}
+	for {
+		fn(indexes)
+		ii := k - 1
+		for ii >= 0 && indexes[ii] == n-k+ii {
+			ii--
+		}
+		if ii < 0 {
+			return
+		}
+		indexes[ii]++
+		for jj := ii + 1; jj < k; jj++ {
+			indexes[jj] = indexes[jj-1] + 1
+		}
+	}
+}
+
+// GetExactDiscards returns the possible discards for a hand sorted by best
+// first, with the held and crib averages calculated exactly from the unseen
+// cards rather than from the discard tables
+func (hand Hand) GetExactDiscards(unseen Hand, playersCrib bool) (discards []Discard) {
+	return SixCardRules.GetExactDiscards(hand, unseen, playersCrib)
+}
+
+// GetExactDiscards returns the possible discards for a hand sorted by best
+// first, with the held and crib averages calculated exactly from the unseen cards
+func (rules Rules) GetExactDiscards(hand Hand, unseen Hand, playersCrib bool) (discards []Discard) {
+	return rules.getExpectedDiscards(hand, unseen, playersCrib, func(discarded Hand) float64 {
+		return rules.CribExpectation(discarded, unseen)
+	})
+}
+
+// GetSampledDiscards returns the possible discards for a hand sorted by best
+// first, with the crib averages estimated from samples drawn from the unseen cards
+func (hand Hand) GetSampledDiscards(unseen Hand, playersCrib bool, samples int, random *rand.Rand) (discards []Discard) {
+	return SixCardRules.GetSampledDiscards(hand, unseen, playersCrib, samples, random)
+}
+
+// GetSampledDiscards returns the possible discards for a hand sorted by best
+// first, with the crib averages estimated from samples drawn from the unseen cards
+func (rules Rules) GetSampledDiscards(hand Hand, unseen Hand, playersCrib bool, samples int,
+	random *rand.Rand) (discards []Discard) {
+	if random == nil {
+		random = newRandom()
+	}
+	return rules.getExpectedDiscards(hand, unseen, playersCrib, func(discarded Hand) float64 {
+		return rules.SampleCribExpectation(discarded, unseen, samples, random)
+	})
+}
+
+// getExpectedDiscards builds and sorts the discards of a hand using a crib evaluator
+func (rules Rules) getExpectedDiscards(hand Hand, unseen Hand, playersCrib bool,
+	crib func(discarded Hand) float64) (discards []Discard) {
+	deck := Deck{Cards: copyHand(unseen)}
+	deck.GetFrequencies()
+	discards = rules.BuildPossibleHolds(hand, &deck, playersCrib)
+	for ii := range discards {
+		discards[ii].HeldAverage = float32(rules.ExpectedScore(discards[ii].Held, unseen))
+		discards[ii].DiscardedAverage = float32(crib(discards[ii].Discarded))
+	}
+	sortDiscards(discards, playersCrib, rules.Lowball)
+	return
+}
@@ -0,0 +1,104 @@
+package poner_test
+
+import (
+	"math"
+	"math/rand"
+	"testing"
+
+	"github.com/blakecallens/poner"
+)
+
+func TestExpectedScore(t *testing.T) {
+	deck := poner.Deck{}.New()
+	hand, err := deck.PullCards("5h 5d 5s Jc")
+	if err != nil {
+		t.Errorf("Error pulling cards from deck: %v", err)
+		return
+	}
+	unseen, err := deck.PullCards("5c 2h")
+	if err != nil {
+		t.Errorf("Error pulling cards from deck: %v", err)
+		return
+	}
+	// 29 with the 5♣ and 14 with the 2♥
+	expected := hand.ExpectedScore(unseen)
+	if expected != 21.5 {
+		t.Errorf("Error getting expected score, got %v, want 21.5", expected)
+	}
+}
+
+func TestCribExpectation(t *testing.T) {
+	deck := poner.Deck{}.New()
+	discarded, err := deck.PullCards("5c Jh")
+	if err != nil {
+		t.Errorf("Error pulling cards from deck: %v", err)
+		return
+	}
+	unseen, err := deck.PullCards("Js Qh Kh 10h 5d 4h Jd Ah")
+	if err != nil {
+		t.Errorf("Error pulling cards from deck: %v", err)
+		return
+	}
+	// Score every ordered choice of the other two crib cards and the starter
+	total, count := 0, 0
+	for ii := range unseen {
+		for jj := ii + 1; jj < len(unseen); jj++ {
+			for kk := range unseen {
+				if kk == ii || kk == jj {
+					continue
+				}
+				crib := poner.Hand{discarded[0], discarded[1], unseen[ii], unseen[jj]}
+				_, score := crib.Score(unseen[kk], true)
+				total += score
+				count++
+			}
+		}
+	}
+	want := float64(total) / float64(count)
+	got := discarded.CribExpectation(unseen)
+	if math.Abs(got-want) > 1e-9 {
+		t.Errorf("Error getting crib expectation, got %v, want %v", got, want)
+	}
+
+	deck.SetSeed(7)
+	sampled := discarded.SampleCribExpectation(unseen, 20000, deck.Random)
+	if math.Abs(sampled-want) > 0.1 {
+		t.Errorf("Error sampling crib expectation, got %v, want about %v", sampled, want)
+	}
+}
+
+func TestGetExactDiscards(t *testing.T) {
+	deck := poner.Deck{}.New()
+	hand, err := deck.PullCards("5c 5d Jh 10s 2c 9d")
+	if err != nil {
+		t.Errorf("Error pulling cards from deck: %v", err)
+		return
+	}
+	unseen := poner.Hand(deck.Cards)
+	discards := hand.GetExactDiscards(unseen, true)
+	if len(discards) != 15 {
+		t.Errorf("Error getting exact discards, got %v discards, want 15", len(discards))
+		return
+	}
+	best := discards[0]
+	if !best.Discarded.Contains(hand[4]) || !best.Discarded.Contains(hand[5]) {
+		t.Errorf("Error getting exact discards, got %v, want 2♣ 9♦ discarded", best.Discarded)
+	}
+
+	sampled := hand.GetSampledDiscards(unseen, true, 2000, rand.New(rand.NewSource(1)))
+	if sampled[0].HeldAverage != best.HeldAverage {
+		t.Errorf("Error getting sampled discards, got held average %v, want %v", sampled[0].HeldAverage, best.HeldAverage)
+	}
+	exact := poner.Hand{hand[4], hand[5]}.CribExpectation(unseen)
+	sampledCrib := poner.Hand{hand[4], hand[5]}.SampleCribExpectation(unseen, 2000, rand.New(rand.NewSource(1)))
+	if math.Abs(sampledCrib-exact) > 0.25 {
+		t.Errorf("Error sampling crib expectation, got %v, want within 0.25 of %v", sampledCrib, exact)
+	}
+	for _, discard := range sampled {
+		if discard.Discarded.Contains(hand[4]) && discard.Discarded.Contains(hand[5]) &&
+			math.Abs(float64(discard.DiscardedAverage-best.DiscardedAverage)) > 0.25 {
+			t.Errorf("Error getting sampled discards, got crib average %v, want within 0.25 of %v",
+				discard.DiscardedAverage, best.DiscardedAverage)
+		}
+	}
+}
@@ -0,0 +1,134 @@
+package poner
+
+import (
+	"math/bits"
+)
+
+// noSuit is the suit of a starter known only by its rank, which matches no card
+const noSuit = -1
+
+// ScoreTotal returns the total Score would give a hand of three to five
+// cards, without building its pairings. Four card hands are looked up in a
+// precomputed table
+func (hand Hand) ScoreTotal(starter Card, isCrib bool) int {
+	return SixCardRules.ScoreTotal(hand, starter, isCrib)
+}
+
+// FastScore scores a hand like Score. The total comes from the fast scorer,
+// and the scores are only built when asked for
+func (hand Hand) FastScore(starter Card, isCrib bool, withScores bool) (scores []Score, total int) {
+	total = hand.ScoreTotal(starter, isCrib)
+	if !withScores {
+		return
+	}
+	if total == 0 {
+		return []Score{}, 0
+	}
+	return hand.Score(starter, isCrib)
+}
+
+// ScoreTotal returns the total Score would give a hand of three to five cards
+// under the rules, without building its pairings
+func (rules Rules) ScoreTotal(hand Hand, starter Card, isCrib bool) (total int) {
+	mask, err := hand.Mask()
+	id := starter.ID()
+	if err != nil || id == NoCard || mask.Contains(id) {
+		_, total = rules.Score(hand, starter, isCrib)
+		return
+	}
+	return rules.maskScore(mask, id.Order(), id.Suit(), isCrib)
+}
+
+// ScoreTotal returns the total a hand of three to five cards scores with the
+// starter under the standard rules
+func (mask CardMask) ScoreTotal(starter CardID, isCrib bool) int {
+	if starter >= 52 || mask.Contains(starter) {
+		return 0
+	}
+	return SixCardRules.maskScore(mask, starter.Order(), starter.Suit(), isCrib)
+}
+
+// maskScore scores a hand of three to five cards with a starter of an order
+// and suit. Four card hands look their ranks up in the score table. Otherwise
+// fifteens come from the sums of every subset of the cards, of a kinds from
+// the count of each rank and runs from the bits of the ranks held
+func (rules Rules) maskScore(hand CardMask, starterOrder int, starterSuit int, isCrib bool) (total int) {
+	size := hand.Len()
+	if size < 3 || size > 5 || hand>>52 != 0 {
+		return
+	}
+	counts := [13]int{}
+	cardValues := [6]int{values[starterOrder]}
+	counts[starterOrder]++
+	ranks := 1 << uint(starterOrder)
+	handSuit, isFlush := noSuit, true
+	cards := 1
+	for remaining := hand; remaining != 0; remaining &= remaining - 1 {
+		id := CardID(bits.TrailingZeros64(uint64(remaining)))
+		order := id.Order()
+		counts[order]++
+		ranks |= 1 << uint(order)
+		cardValues[cards] = values[order]
+		cards++
+		if handSuit == noSuit {
+			handSuit = id.Suit()
+		} else if id.Suit() != handSuit {
+			isFlush = false
+		}
+	}
+
+	if size == 4 && len(scoreTableData) == scoreTableSize {
+		total = int(scoreTableData[rankIndex(&counts)])
+	} else {
+		sums := [64]int{}
+		for subset := 1; subset < 1<<uint(cards); subset++ {
+			sums[subset] = sums[subset&(subset-1)] + cardValues[bits.TrailingZeros(uint(subset))]
+			if sums[subset] == 15 {
+				total += fifteen.Value
+			}
+		}
+		for _, count := range counts {
+			total += count * (count - 1)
+		}
+		total += runsTotal(ranks, &counts)
+	}
+
+	if isFlush {
+		if starterSuit == handSuit {
+			total += size + 1
+		} else if !rules.HandFlushStarter && !(isCrib && rules.CribFlushStarter) {
+			total += size
+		}
+	}
+	if starterSuit != noSuit && rules.Nobs > 0 && hand.Contains(CardID(starterSuit*13+10)) {
+		total += rules.Nobs
+	}
+	if total == 0 && rules.Nineteen > 0 {
+		total = rules.Nineteen
+	}
+	return
+}
+
+// runsTotal scores the longest runs in a set of ranks, once for every way of
+// picking their cards
+func runsTotal(ranks int, counts *[13]int) (total int) {
+	longest := 0
+	for order := 0; order < 13; order++ {
+		if ranks&(1<<uint(order)) == 0 {
+			continue
+		}
+		start, ways := order, 1
+		for ; order < 13 && ranks&(1<<uint(order)) != 0; order++ {
+			ways *= counts[order]
+		}
+		length := order - start
+		if length < 3 || length < longest {
+			continue
+		}
+		if length > longest {
+			longest, total = length, 0
+		}
+		total += length * ways
+	}
+	return
+}
@@ -0,0 +1,101 @@
+package poner_test
+
+import (
+	"math/rand"
+	"reflect"
+	"testing"
+
+	"github.com/blakecallens/poner"
+)
+
+// randomHand deals a hand of size cards and a starter from a shuffled deck
+func randomHand(random *rand.Rand, size int) (hand poner.Hand, starter poner.Card) {
+	deck := poner.Deck{}.New()
+	cards := deck.Cards
+	random.Shuffle(len(cards), func(ii, jj int) { cards[ii], cards[jj] = cards[jj], cards[ii] })
+	return append(poner.Hand{}, cards[:size]...), cards[size]
+}
+
+func TestScoreTotal(t *testing.T) {
+	house := poner.SixCardRules
+	house.CribFlushStarter, house.HandFlushStarter, house.Nobs, house.Nineteen = false, true, 2, 1
+	random := rand.New(rand.NewSource(24))
+	for ii := 0; ii < 20000; ii++ {
+		hand, starter := randomHand(random, 3+ii%3)
+		isCrib := ii%2 == 0
+		for _, rules := range []poner.Rules{poner.SixCardRules, house} {
+			_, want := rules.Score(hand, starter, isCrib)
+			if total := rules.ScoreTotal(hand, starter, isCrib); total != want {
+				t.Errorf("Error scoring %v %v with %v, got %v, want %v", hand, starter, rules.Name, total, want)
+			}
+		}
+		mask, _ := hand.Mask()
+		_, want := hand.Score(starter, isCrib)
+		if total := mask.ScoreTotal(starter.ID(), isCrib); total != want {
+			t.Errorf("Error scoring mask of %v %v, got %v, want %v", hand, starter, total, want)
+		}
+	}
+
+	// Hands with every run, of a kind and fifteen
+	deck := poner.Deck{}.New()
+	for _, cards := range []string{"3h 4h 5s 5c", "3h 3c 3s 3d", "5h 5c 5s Jh", "As 2h 3c 5d", "Kh Qd Jc 10s 9h", "Ah 2h 4c 5d 6s"} {
+		hand, err := deck.PullCards(cards)
+		if err != nil {
+			t.Errorf("Error pulling cards from deck: %v", err)
+			return
+		}
+		starter := deck.Cards[len(deck.Cards)-1]
+		_, want := hand.Score(starter, false)
+		if total := hand.ScoreTotal(starter, false); total != want {
+			t.Errorf("Error scoring %v %v, got %v, want %v", hand, starter, total, want)
+		}
+		deck = poner.Deck{}.New()
+	}
+}
+
+func TestFastScore(t *testing.T) {
+	random := rand.New(rand.NewSource(25))
+	for ii := 0; ii < 1000; ii++ {
+		hand, starter := randomHand(random, 4)
+		wantScores, want := hand.Score(starter, false)
+		scores, total := hand.FastScore(starter, false, true)
+		if total != want || !reflect.DeepEqual(scores, wantScores) {
+			t.Errorf("Error scoring %v %v, got %v %v, want %v %v", hand, starter, total, scores, want, wantScores)
+		}
+		scores, total = hand.FastScore(starter, false, false)
+		if total != want || scores != nil {
+			t.Errorf("Error scoring total of %v %v, got %v %v, want %v", hand, starter, total, scores, want)
+		}
+	}
+}
+
+func BenchmarkScore(b *testing.B) {
+	hand, starter := randomHand(rand.New(rand.NewSource(1)), 4)
+	for ii := 0; ii < b.N; ii++ {
+		hand.Score(starter, false)
+	}
+}
+
+func BenchmarkScoreTotal(b *testing.B) {
+	hand, starter := randomHand(rand.New(rand.NewSource(1)), 4)
+	for ii := 0; ii < b.N; ii++ {
+		hand.ScoreTotal(starter, false)
+	}
+}
+
+func BenchmarkCardMaskScoreTotal(b *testing.B) {
+	hand, starter := randomHand(rand.New(rand.NewSource(1)), 4)
+	mask, _ := hand.Mask()
+	id := starter.ID()
+	for ii := 0; ii < b.N; ii++ {
+		mask.ScoreTotal(id, false)
+	}
+}
+
+func BenchmarkGetDiscards(b *testing.B) {
+	deck := poner.Deck{}.New()
+	hand, _ := deck.PullCards("2c 3c 4d 5h 5c Jc")
+	for ii := 0; ii < b.N; ii++ {
+		hand.GetDiscards(&deck, true)
+	}
+}
@@ -0,0 +1,102 @@
+package poner_test
+
+import (
+	"bytes"
+	"strings"
+	"testing"
+
+	"github.com/blakecallens/poner"
+)
+
+func TestFiveCard(t *testing.T) {
+	players := []poner.Player{
+		{Name: "Bob", IsComputer: true, SkillLevel: 4},
+		{Name: "Sue", IsComputer: false, SkillLevel: 4},
+	}
+	game := poner.Game{Rules: poner.FiveCardRules}
+	game.SetSeed(5)
+	game.New(players)
+	if game.ToWin != 61 {
+		t.Errorf("Error starting five-card game, got %v points to win, want 61", game.ToWin)
+	}
+
+	for actions := 0; game.Phase != poner.PhaseGameOver; actions++ {
+		if actions > 10000 {
+			t.Error("Error applying actions, game did not finish")
+			return
+		}
+		action, err := humanAction(&game, 1)
+		if err != nil {
+			action, err = game.ComputerAction()
+		}
+		if err != nil {
+			t.Errorf("Error getting action in %v: %v", game.Phase, err)
+			return
+		}
+		_, err = game.Apply(action)
+		if err != nil {
+			t.Errorf("Error applying %v: %v", action, err)
+			return
+		}
+		if game.Phase == poner.PhasePegging {
+			for _, player := range game.Players {
+				if len(player.DealtHand) != 5 || len(player.Discard.Held) != 3 || len(game.Crib) != 4 {
+					t.Errorf("Error dealing, got %v dealt, %v held and crib %v",
+						player.DealtHand, player.Discard.Held, game.Crib)
+					return
+				}
+			}
+		}
+	}
+
+	// The first pone pegs three for last, and each round has a single count
+	three := -1
+	counts := map[int]int{}
+	for index, event := range game.Events {
+		switch event.Type {
+		case poner.EventThreeForLast:
+			if three >= 0 || event.Round != 1 || event.Player == game.Events[index-1].Dealer {
+				t.Errorf("Error scoring three for last, got %v after %v", event, game.Events[index-1])
+			}
+			three = index
+		case poner.EventResetField:
+			counts[event.Round]++
+		}
+	}
+	if three != 2 {
+		t.Errorf("Error scoring three for last, got event %v, want the one after the first deal", three)
+	}
+	for round, count := range counts {
+		if count > 1 {
+			t.Errorf("Error pegging, got %v counts in round %v, want 1", count, round)
+		}
+	}
+
+	written := bytes.Buffer{}
+	err := game.WriteNotation(&written)
+	if err != nil {
+		t.Errorf("Error writing notation: %v", err)
+		return
+	}
+	events, err := poner.ParseNotation(strings.NewReader(written.String()))
+	if err != nil {
+		t.Errorf("Error parsing notation: %v\n%v", err, written.String())
+		return
+	}
+	replayed, err := poner.Replay(events, len(events))
+	if err != nil {
+		t.Errorf("Error replaying notation: %v", err)
+		return
+	}
+	for ii, player := range replayed.Players {
+		if player.Score != game.Players[ii].Score {
+			t.Errorf("Error replaying notation, got score %v, want %v", player.Score, game.Players[ii].Score)
+		}
+	}
+
+	without := strings.Replace(written.String(), "\nThree ", "\n; Three ", 1)
+	_, err = poner.ParseNotation(strings.NewReader(without))
+	if err == nil {
+		t.Error("Error parsing notation, did not get err for a missing three for last")
+	}
+}
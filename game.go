@@ -4,37 +4,135 @@ import (
 	"errors"
 	"fmt"
 	"math/rand"
-	"time"
 )
 
+// ErrGameOver is returned for any action taken after a player has won
+var ErrGameOver = errors.New("game is over")
+
+// ShowScore is the count of a single hand or crib in the show
+type ShowScore struct {
+	Player int
+	IsCrib bool
+	Hand   Hand
+	Scores []Score
+	Total  int
+}
+
 // Game represents
 type Game struct {
-	Players      []Player
-	Round        int
-	ToWin        int
-	Dealer       int
-	ActivePlayer int
-	Deck         Deck
-	Starter      Card
-	Field        Hand
-	Crib         Hand
-	Winner       *Player
+	Players        []Player
+	Round          int
+	ToWin          int
+	Dealer         int
+	ActivePlayer   int
+	Deck           Deck
+	Starter        Card
+	Field          Hand
+	Crib           Hand
+	Winner         *Player
+	Random         *rand.Rand
+	FairDeal       bool
+	DealCommitment string
+	Events         []Event
+	Phase          Phase
+	LastPlayer     int
+	Teams          bool
+	Rules          Rules
+	Muggins        bool
+	OverClaim      OverClaim
+	Missed         *MissedScore
+	nextSeed       *ShuffleSeed
+	dealSeed       *ShuffleSeed
+	firstDealer    *int
+}
+
+// SetSource sets the random source used for the whole game, so a game
+// can be replayed by supplying the same source
+func (game *Game) SetSource(source rand.Source) {
+	game.Random = rand.New(source)
+}
+
+// SetSeed seeds the game so it can be replayed from the same seed
+func (game *Game) SetSeed(seed int64) {
+	game.SetSource(rand.NewSource(seed))
 }
 
 // New creates a new game
 func (game *Game) New(players []Player) {
-	rand.Seed(time.Now().UnixNano())
+	if game.Random == nil {
+		game.Random = newRandom()
+	}
 	game.Players = players
+	game.seedPlayers()
 	game.Round = 0
-	game.Dealer = rand.Intn(len(game.Players))
+	if game.firstDealer != nil && *game.firstDealer < len(game.Players) {
+		// NextRound passes the deal before dealing
+		game.Dealer = (*game.firstDealer + len(game.Players) - 1) % len(game.Players)
+	} else {
+		game.Dealer = game.Random.Intn(len(game.Players))
+	}
+	game.firstDealer = nil
 	game.Winner = nil
+	game.Phase = PhaseDeal
+	game.Rules = game.rules()
 	if game.ToWin == 0 {
-		game.ToWin = 121
+		game.ToWin = game.Rules.ToWin
+	}
+
+	game.Events = []Event{}
+	rules := game.Rules
+	initial := []Player{}
+	for _, player := range game.Players {
+		player.DealtHand, player.PlayingHand, player.Discard = nil, nil, Discard{}
+		initial = append(initial, player)
+	}
+	game.record(Event{Type: EventNewGame, Players: initial, ToWin: game.ToWin, Dealer: game.Dealer,
+		Muggins: game.Muggins, OverClaim: game.OverClaim, Teams: game.Teams, Rules: &rules})
+}
+
+// seedPlayers gives each player a random source of its own, seeded from the
+// game's, so strategies drawing from it don't change the deals
+func (game *Game) seedPlayers() {
+	for ii := range game.Players {
+		game.Players[ii].Random = rand.New(rand.NewSource(game.Random.Int63()))
 	}
 }
 
+// SetFirstDealer sets who deals the first round, instead of the random cut.
+// It must be called before New, so the new game event records the dealer
+func (game *Game) SetFirstDealer(index int) (err error) {
+	if index < 0 {
+		err = fmt.Errorf("SetFirstDealer:: invalid player %v", index)
+		return
+	}
+	if len(game.Events) > 0 {
+		err = errors.New("SetFirstDealer:: the game has already started")
+		return
+	}
+	game.firstDealer = &index
+	return
+}
+
 // NextRound starts a new game round
 func (game *Game) NextRound() (score Score, err error) {
+	if game.Winner != nil {
+		err = ErrGameOver
+		return
+	}
+	err = game.deal()
+	if err != nil || !game.AllPlayersDiscarded() {
+		return
+	}
+	score, err = game.cut()
+	return
+}
+
+// deal shuffles and deals the cards for a new round
+func (game *Game) deal() (err error) {
+	err = game.rules().Validate(len(game.Players))
+	if err != nil {
+		return
+	}
 	game.Round++
 	game.Dealer++
 	if game.Dealer >= len(game.Players) {
@@ -43,15 +141,63 @@ func (game *Game) NextRound() (score Score, err error) {
 	game.ActivePlayer = game.Dealer
 
 	game.Field = Hand{}
-	game.Deck = Deck{}.New()
-	game.Deck.Shuffle()
-	game.Deck.Cut()
+	game.Deck = Deck{Random: game.Random}.New()
+	if game.FairDeal {
+		err = game.fairShuffle()
+		if err != nil {
+			return
+		}
+	} else {
+		game.dealSeed = nil
+		game.Deck.Shuffle()
+		game.Deck.Cut()
+	}
 
-	hands, _ := game.Deck.DealCribbage(len(game.Players))
+	hands, _ := game.Deck.Deal(game.rules().Dealt(len(game.Players)), len(game.Players))
+	dealt := []Hand{}
+	for _, hand := range hands {
+		dealt = append(dealt, copyHand(hand))
+	}
+	// With three players the crib is dealt a card after the hands, before the cut
+	game.Crib = Hand{}
+	for ii := 0; ii < game.cribCards(); ii++ {
+		var card Card
+		card, err = game.Deck.PullFromTop()
+		if err != nil {
+			return
+		}
+		game.Crib = append(game.Crib, card)
+	}
+	event := Event{Type: EventDeal, Player: game.Dealer, Dealer: game.Dealer, Hands: dealt,
+		Cards: copyHand(game.Deck.Cards)}
+	if len(game.Crib) > 0 {
+		event.Card = game.Crib[0]
+	}
+	game.record(event)
 	for index, hand := range hands {
-		game.Players[index].TakeDeal(hand, &game.Deck, index == game.Dealer)
+		player := &game.Players[index]
+		player.DealtHand, player.Discard, player.PlayingHand = hand, Discard{}, Hand{}
+	}
+	game.Phase = PhaseDiscard
+	if game.Round == 1 {
+		game.poneStart()
 	}
+	for index := range game.Players {
+		player := &game.Players[index]
+		err = player.TakeDealView(game.PlayerView(index))
+		if err != nil {
+			return
+		}
+		if len(player.Discard.Held) > 0 {
+			game.record(Event{Type: EventDiscard, Player: index, Cards: copyHand(player.Discard.Discarded)})
+		}
+	}
+	return
+}
 
+// cut builds the crib and turns the starter card
+func (game *Game) cut() (score Score, err error) {
+	game.Phase = PhaseCut
 	err = game.BuildCrib()
 	if err != nil {
 		return
@@ -60,19 +206,105 @@ func (game *Game) NextRound() (score Score, err error) {
 	if err != nil {
 		return
 	}
+	game.record(Event{Type: EventStarter, Player: game.Dealer, Card: game.Starter, Cards: copyHand(game.Crib)})
+	game.Phase = PhasePegging
 
-	score = game.Starter.HisHeelsScore()
+	score = game.rules().HisHeelsScore(game.Starter)
 	if score.Value > 0 {
 		player := &game.Players[game.Dealer]
-		player.AddScore([]Score{score})
+		game.addScore(player, []Score{score})
+		game.record(Event{Type: EventHisHeels, Player: game.Dealer, Scores: []Score{score}})
 		game.CheckForWinner(player)
 	}
 	return
 }
 
-// BuildCrib creates the crib from the discards
+// poneStart gives the player left of the first dealer the points the rules
+// give the pone at the start of the game, such as five-card's three for last
+func (game *Game) poneStart() {
+	score := game.rules().PoneStartScore()
+	if score.Value == 0 {
+		return
+	}
+	index := game.nextIndex(game.Dealer)
+	player := &game.Players[index]
+	game.addScore(player, []Score{score})
+	game.record(Event{Type: EventThreeForLast, Player: index, Scores: []Score{score}})
+	game.CheckForWinner(player)
+}
+
+// rules returns the game's rules, which are six-card rules if none are set
+func (game *Game) rules() Rules {
+	return game.Rules.orDefault()
+}
+
+// HandSize returns how many cards each player keeps after discarding
+func (game *Game) HandSize() int {
+	return game.rules().HandSize
+}
+
+// cribCards returns how many cards are dealt straight to the crib
+func (game *Game) cribCards() int {
+	return game.rules().cribCards(len(game.Players))
+}
+
+// DiscardCount returns how many cards each player discards to the crib
+func (game *Game) DiscardCount() int {
+	return game.rules().Discards(len(game.Players))
+}
+
+// HumanDiscard sets a human player's discard from their dealt hand. Once
+// every player has discarded the crib is built and the starter is turned
+func (game *Game) HumanDiscard(playerIndex int, cards Hand) (score Score, err error) {
+	if playerIndex < 0 || playerIndex >= len(game.Players) {
+		err = fmt.Errorf("HumanDiscard:: invalid player %v", playerIndex)
+		return
+	}
+	if game.Winner != nil {
+		err = ErrGameOver
+		return
+	}
+	player := &game.Players[playerIndex]
+	if player.IsComputer {
+		err = fmt.Errorf("HumanDiscard:: player %v is not human", playerIndex)
+		return
+	}
+	if game.Phase != PhaseDiscard {
+		err = fmt.Errorf("HumanDiscard:: cannot discard during %v", game.Phase)
+		return
+	}
+	if len(player.Discard.Held) > 0 {
+		err = fmt.Errorf("HumanDiscard:: player %v has already discarded", playerIndex)
+		return
+	}
+	if len(cards) != game.DiscardCount() {
+		err = fmt.Errorf("HumanDiscard:: %v cards discarded, want %v", len(cards), game.DiscardCount())
+		return
+	}
+	held := copyHand(player.DealtHand)
+	for _, card := range cards {
+		if !held.Contains(card) {
+			err = fmt.Errorf("HumanDiscard:: %v was not dealt to player %v", card, playerIndex)
+			return
+		}
+		held = held.RemoveCard(card)
+	}
+
+	player.SetDiscard(game.rules().BuildDiscard(held, copyHand(cards), &game.Deck, playerIndex == game.Dealer))
+	game.record(Event{Type: EventDiscard, Player: playerIndex, Cards: copyHand(cards)})
+	if game.AllPlayersDiscarded() {
+		score, err = game.cut()
+	}
+	return
+}
+
+// BuildCrib creates the crib from the discards and any card dealt to the crib
 func (game *Game) BuildCrib() (err error) {
 	crib := Hand{}
+	dealt := Hand{}
+	if len(game.Crib) >= game.cribCards() {
+		dealt = game.Crib[:game.cribCards()]
+	}
 	discards := []Discard{}
 	for _, player := range game.Players {
 		discards = append(discards, player.Discard)
@@ -84,7 +316,8 @@ func (game *Game) BuildCrib() (err error) {
 	for _, discard := range discards {
 		crib = append(crib, discard.Discarded...)
 	}
-	for len(crib) < 4 {
+	crib = append(crib, dealt...)
+	for len(crib) < game.rules().CribSize {
 		var card Card
 		card, err = game.Deck.PullFromTop()
 		if err != nil {
@@ -106,8 +339,12 @@ func (game *Game) AllPlayersGone() bool {
 	return true
 }
 
-// AllPlaysDone returns whether all players have emptied their hands
+// AllPlaysDone returns whether all players have emptied their hands. In
+// five-card cribbage the pegging also ends with the first count
 func (game *Game) AllPlaysDone() bool {
+	if game.rules().SingleCount && game.countEnded() {
+		return true
+	}
 	for _, player := range game.Players {
 		if len(player.PlayingHand) > 0 {
 			return false
@@ -116,6 +353,26 @@ func (game *Game) AllPlaysDone() bool {
 	return true
 }
 
+// countEnded returns whether a card has been played and the count can't go on
+func (game *Game) countEnded() bool {
+	played := false
+	for _, player := range game.Players {
+		played = played || len(player.Discard.Played) > 0
+	}
+	if !played {
+		return false
+	}
+	if len(game.Field) == 0 || game.Field.GetTotal() == game.rules().PegLimit {
+		return true
+	}
+	for _, player := range game.Players {
+		if !player.Gone && len(player.PlayingHand) > 0 {
+			return false
+		}
+	}
+	return true
+}
+
 // ResetField resets the playing field
 func (game *Game) ResetField() {
 	game.Field = Hand{}
@@ -123,14 +380,16 @@ func (game *Game) ResetField() {
 		player := &game.Players[ii]
 		player.Gone = false
 	}
+	game.record(Event{Type: EventResetField})
 }
 
 // GoScore calculates whether a finished field is a go
 func (game *Game) GoScore() (score Score) {
-	if game.Field.GetTotal() != 31 {
+	if game.Winner == nil && game.Field.GetTotal() != game.rules().PegLimit {
 		score = goScore.AddPairing(game.Field)
 		player := &game.Players[game.ActivePlayer]
-		player.AddScore([]Score{score})
+		game.addScore(player, []Score{score})
+		game.record(Event{Type: EventGoScore, Player: game.ActivePlayer, Scores: []Score{score}})
 		game.CheckForWinner(player)
 	}
 	return score
@@ -138,6 +397,10 @@ func (game *Game) GoScore() (score Score) {
 
 // NextPlayer runs the next player turn
 func (game *Game) NextPlayer() (isHuman bool, card Card, scores []Score, err error) {
+	if game.Winner != nil {
+		err = ErrGameOver
+		return
+	}
 	game.ActivePlayer++
 	if game.ActivePlayer >= len(game.Players) {
 		game.ActivePlayer = 0
@@ -149,29 +412,72 @@ func (game *Game) NextPlayer() (isHuman bool, card Card, scores []Score, err err
 		return
 	}
 
-	nextPlayer := game.ActivePlayer + 1
-	if nextPlayer >= len(game.Players) {
-		nextPlayer = 0
+	card, cantPlay, err := game.computerPlay(game.ActivePlayer)
+	if err != nil {
+		return
 	}
-
-	plays, cantPlay := player.PlayingHand.GetPlays(game.Field, game.Players[nextPlayer])
 	if cantPlay {
 		player.Gone = true
+		game.record(Event{Type: EventGo, Player: game.ActivePlayer, Total: game.Field.GetTotal()})
 		return
 	}
-	skillAdjust := player.GetSkillAdjust(len(plays))
-	scores, err = game.PutCardIntoField(plays[skillAdjust].Card, player)
+	scores, err = game.PutCardIntoField(card, player)
 	return
 }
 
+// computerPlay asks a computer player's strategy for the card to put into the field
+func (game *Game) computerPlay(index int) (card Card, cantPlay bool, err error) {
+	player := &game.Players[index]
+	card, cantPlay = player.GetStrategy().ChoosePlay(game.PlayerView(index))
+	rules := game.rules()
+	if cantPlay != !rules.CanPlay(player.PlayingHand, game.Field) ||
+		(!cantPlay && (!player.PlayingHand.Contains(card) || !rules.CanBePlayed(card, game.Field))) {
+		err = fmt.Errorf("computerPlay:: invalid play of %v by player %v", card, index)
+	}
+	return
+}
+
+// roundPegging returns the current round's play, go and field reset events
+func (game *Game) roundPegging() (events []Event) {
+	events = []Event{}
+	for _, event := range game.Events {
+		if event.Round != game.Round {
+			continue
+		}
+		switch event.Type {
+		case EventPlay, EventGo, EventResetField:
+			events = append(events, event)
+		}
+	}
+	return
+}
+
+// Scores returns every player's score
+func (game *Game) Scores() (scores []int) {
+	scores = []int{}
+	for _, player := range game.Players {
+		scores = append(scores, player.Score)
+	}
+	return
+}
+
+// nextIndex returns the index of the player to the left of the supplied player
+func (game *Game) nextIndex(index int) int {
+	return (index + 1) % len(game.Players)
+}
+
 // HumanPlayCard acts upon a human selected card for the playfield
 func (game *Game) HumanPlayCard(card Card) (scores []Score, err error) {
+	if game.Winner != nil {
+		err = ErrGameOver
+		return
+	}
 	player := &game.Players[game.ActivePlayer]
 	if player.IsComputer {
 		err = errors.New("HumanPlayCard:: the active player is not human")
 		return
 	}
-	if !card.CanBePlayed(game.Field) {
+	if !game.rules().CanBePlayed(card, game.Field) {
 		err = fmt.Errorf("HumanPlayCard:: %v cannot be played", card)
 		return
 	}
@@ -182,13 +488,18 @@ func (game *Game) HumanPlayCard(card Card) (scores []Score, err error) {
 
 // HumanPlayGone acts upon a human saying go
 func (game *Game) HumanPlayGone() (scores []Score, err error) {
+	if game.Winner != nil {
+		err = ErrGameOver
+		return
+	}
 	player := &game.Players[game.ActivePlayer]
 	if player.IsComputer {
 		err = errors.New("HumanPlayGone:: the active player is not human")
 		return
 	}
-	if !player.PlayingHand.CanPlay(game.Field) {
+	if !game.rules().CanPlay(player.PlayingHand, game.Field) {
 		player.Gone = true
+		game.record(Event{Type: EventGo, Player: game.ActivePlayer, Total: game.Field.GetTotal()})
 		return
 	}
 
@@ -198,37 +509,149 @@ func (game *Game) HumanPlayGone() (scores []Score, err error) {
 
 // PutCardIntoField puts a card into the playfield for a player
 func (game *Game) PutCardIntoField(card Card, player *Player) (scores []Score, err error) {
-	field, scores, err := game.Field.Play(card)
+	return game.playCard(card, player, nil)
+}
+
+// playCard puts a card into the playfield, scoring the points claimed by a
+// player's action under muggins
+func (game *Game) playCard(card Card, player *Player, action *Action) (scores []Score, err error) {
+	if game.Winner != nil {
+		err = ErrGameOver
+		return
+	}
+	field, scores, err := game.rules().Play(game.Field, card)
 	if err != nil {
 		return
 	}
 	game.Field = field
+	game.LastPlayer = game.playerIndex(player)
 
-	player.AddScore(scores)
+	scores = game.claimScores(game.LastPlayer, scores, action)
+	game.addScore(player, scores)
 	game.CheckForWinner(player)
 	player.PlayingHand = player.PlayingHand.RemoveCard(card)
 	player.Discard.Played = append(player.Discard.Played, card)
+	game.record(Event{Type: EventPlay, Player: game.LastPlayer, Card: card, Scores: scores,
+		Total: game.Field.GetTotal()})
 
 	return
 }
 
-// ScoreHand scores a player's hand or crib
+// ScoreShow counts the rest of the show in order: each hand starting left of
+// the dealer, the dealer's hand, then the crib. Counting stops at a winner
+func (game *Game) ScoreShow() (shows []ShowScore, err error) {
+	if game.Winner != nil {
+		err = ErrGameOver
+		return
+	}
+	if game.Phase != PhaseShow && game.Phase != PhaseCrib {
+		if !game.AllPlaysDone() {
+			err = errors.New("ScoreShow:: the pegging has not finished")
+			return
+		}
+		game.Phase = PhaseShow
+		game.ActivePlayer = game.nextIndex(game.Dealer)
+	}
+
+	shows = []ShowScore{}
+	for game.Phase == PhaseShow {
+		index := game.ActivePlayer
+		show := ShowScore{Player: index, Hand: game.Players[index].Discard.Held}
+		show.Scores, show.Total = game.showHand(index, nil)
+		shows = append(shows, show)
+	}
+	if game.Phase == PhaseCrib {
+		show := ShowScore{Player: game.Dealer, IsCrib: true, Hand: game.Crib}
+		show.Scores, show.Total = game.showCrib(nil)
+		shows = append(shows, show)
+	}
+	return
+}
+
+// ScoreHand scores a player's hand or crib. Nothing is scored once the game has a winner
 func (game *Game) ScoreHand(player *Player, isCrib bool) (scores []Score, total int) {
+	return game.scoreHand(player, isCrib, nil)
+}
+
+// scoreHand scores a player's hand or crib, with the points claimed by their action under muggins
+func (game *Game) scoreHand(player *Player, isCrib bool, action *Action) (scores []Score, total int) {
+	if game.Winner != nil {
+		return
+	}
+	event := Event{Type: EventShow, Player: game.playerIndex(player), Cards: copyHand(player.Discard.Held)}
 	if !isCrib {
-		scores, total = player.Discard.Held.Score(game.Starter, isCrib)
+		scores, total = game.rules().Score(player.Discard.Held, game.Starter, isCrib)
 	} else {
-		scores, total = game.Crib.Score(game.Starter, isCrib)
+		scores, total = game.rules().Score(game.Crib, game.Starter, isCrib)
+		event.Type, event.Cards = EventCrib, copyHand(game.Crib)
 	}
-	player.AddScore(scores)
+	event.Total = total
+	scores = game.claimScores(event.Player, scores, action)
+	total = game.addScore(player, scores)
+	event.Scores = scores
+	game.record(event)
 	game.CheckForWinner(player)
 	return
 }
 
-// CheckForWinner returns if the supplied player has won the game
+// addScore adds scores to a player's total. Partners share a score, so with
+// teams the partner's total moves with the player's
+func (game *Game) addScore(player *Player, scores []Score) (total int) {
+	total = player.AddScore(scores)
+	if total == 0 || !game.HasTeams() {
+		return
+	}
+	partner := &game.Players[game.nextIndex(game.nextIndex(game.playerIndex(player)))]
+	partner.Score, partner.LastScore = player.Score, player.LastScore
+	return
+}
+
+// HasTeams returns whether the game is played in partnerships, which needs four players
+func (game *Game) HasTeams() bool {
+	return game.Teams && len(game.Players) == 4
+}
+
+// Team returns the team of a player. Partners sit across from each other, so
+// players 0 and 2 are team 0 and players 1 and 3 are team 1. Without teams
+// every player is their own team
+func (game *Game) Team(index int) int {
+	if !game.HasTeams() {
+		return index
+	}
+	return index % 2
+}
+
+// IsPartner returns whether two different players are on the same team
+func (game *Game) IsPartner(index int, other int) bool {
+	return index != other && game.Team(index) == game.Team(other)
+}
+
+// CheckForWinner returns if the supplied player has won the game. With
+// teams the winner's partner wins too. In lowball the player loses instead,
+// and the game goes to the lowest scoring opponent
 func (game *Game) CheckForWinner(player *Player) bool {
 	if player.Score >= game.ToWin {
 		game.Winner = player
+		if game.rules().Lowball {
+			game.Winner = game.lowballWinner(game.playerIndex(player))
+		}
+		game.Phase = PhaseGameOver
 		return true
 	}
 	return false
 }
+
+// lowballWinner returns the lowest scoring opponent of the player who reached
+// the points to win, the first of them to their left on a tie
+func (game *Game) lowballWinner(loser int) (winner *Player) {
+	for index := game.nextIndex(loser); index != loser; index = game.nextIndex(index) {
+		player := &game.Players[index]
+		if game.IsPartner(index, loser) {
+			continue
+		}
+		if winner == nil || player.Score < winner.Score {
+			winner = player
+		}
+	}
+	return
+}
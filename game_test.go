@@ -92,6 +92,230 @@ func TestSim5Players(t *testing.T) {
 	}
 }
 
+func TestSeededGame(t *testing.T) {
+	results := [][]int{}
+	for len(results) < 2 {
+		players := []poner.Player{
+			{Name: "Bob", IsComputer: true, SkillLevel: 2},
+			{Name: "Sue", IsComputer: true, SkillLevel: 1},
+		}
+		game := poner.Game{}
+		game.SetSeed(7)
+		game.New(players)
+		for game.Winner == nil {
+			err := playRound(&game)
+			if err != nil {
+				t.Errorf("Error simulating game: %v", err)
+				return
+			}
+			if game.Winner != nil {
+				break
+			}
+			scorePlayerHands(&game)
+		}
+		results = append(results, []int{game.Round, game.Players[0].Score, game.Players[1].Score})
+	}
+	for ii := range results[0] {
+		if results[0][ii] != results[1][ii] {
+			t.Errorf("Error replaying seeded game, got %v, want %v", results[1], results[0])
+			return
+		}
+	}
+}
+
+func TestThreePlayerDeal(t *testing.T) {
+	players := []poner.Player{
+		{Name: "Bob", IsComputer: true, SkillLevel: 4},
+		{Name: "Sue", IsComputer: false, SkillLevel: 4},
+		{Name: "Dan", IsComputer: true, SkillLevel: 4},
+	}
+	game := poner.Game{}
+	game.New(players)
+	dealer := game.Dealer
+	for round := 1; round <= 3; round++ {
+		_, err := game.Apply(poner.Action{Type: poner.ActionDeal, Player: game.PlayerToAct()})
+		if err != nil {
+			t.Errorf("Error dealing: %v", err)
+			return
+		}
+		dealer = (dealer + 1) % 3
+		if game.Dealer != dealer {
+			t.Errorf("Error rotating the deal in round %v, got dealer %v, want %v", round, game.Dealer, dealer)
+		}
+		deal := poner.Event{}
+		for _, event := range game.Events {
+			if event.Type == poner.EventDeal {
+				deal = event
+			}
+		}
+		if len(game.Crib) != 1 || game.Crib[0] != deal.Card || poner.Hand(game.Deck.Cards).Contains(deal.Card) {
+			t.Errorf("Error dealing the crib card, got crib %v and deal card %v", game.Crib, deal.Card)
+		}
+		for _, player := range game.Players {
+			if len(player.DealtHand) != 5 || player.DealtHand.Contains(deal.Card) {
+				t.Errorf("Error dealing, got hand %v with crib card %v", player.DealtHand, deal.Card)
+			}
+		}
+
+		replayed, err := poner.Replay(game.Events, len(game.Events))
+		if err != nil {
+			t.Errorf("Error replaying deal: %v", err)
+			return
+		}
+		if len(replayed.Crib) != 1 || replayed.Crib[0] != deal.Card {
+			t.Errorf("Error replaying deal, got crib %v, want [%v]", replayed.Crib, deal.Card)
+		}
+
+		for game.Phase != poner.PhaseDeal && game.Phase != poner.PhaseGameOver {
+			action, err := humanAction(&game, 1)
+			if err != nil {
+				action, err = game.ComputerAction()
+			}
+			if err == nil {
+				_, err = game.Apply(action)
+			}
+			if err != nil {
+				t.Errorf("Error playing round %v: %v", round, err)
+				return
+			}
+			if game.Phase == poner.PhasePegging && !game.Crib.Contains(deal.Card) {
+				t.Errorf("Error building the crib, got %v without the crib card %v", game.Crib, deal.Card)
+			}
+		}
+	}
+}
+
+func TestHumanDiscard(t *testing.T) {
+	players := []poner.Player{
+		{Name: "Bob", IsComputer: true, SkillLevel: 4},
+		{Name: "Sue", IsComputer: false, SkillLevel: 4},
+		{Name: "Dan", IsComputer: false, SkillLevel: 4},
+	}
+	game := poner.Game{}
+	game.New(players)
+	_, err := game.NextRound()
+	if err != nil {
+		t.Errorf("Error starting round: %v", err)
+		return
+	}
+	if len(game.Crib) != 1 || game.Phase != poner.PhaseDiscard {
+		t.Errorf("Error starting round, got crib %v in %v, want only the dealt crib card before discards",
+			game.Crib, game.Phase)
+	}
+
+	_, err = game.HumanDiscard(0, game.Players[0].DealtHand[:1])
+	if err == nil {
+		t.Error("Error discarding, did not get err for computer player")
+	}
+	_, err = game.HumanDiscard(1, game.Players[1].DealtHand[:2])
+	if err == nil {
+		t.Error("Error discarding, did not get err for too many cards")
+	}
+	_, err = game.HumanDiscard(1, game.Players[2].DealtHand[:1])
+	if err == nil {
+		t.Error("Error discarding, did not get err for card not dealt to player")
+	}
+	_, err = game.HumanDiscard(1, game.Players[1].DealtHand[:1])
+	if err != nil {
+		t.Errorf("Error discarding: %v", err)
+		return
+	}
+	_, err = game.HumanDiscard(1, game.Players[1].DealtHand[1:2])
+	if err == nil {
+		t.Error("Error discarding, did not get err for discarding twice")
+	}
+	if len(game.Players[1].PlayingHand) != 4 || len(game.Crib) != 1 {
+		t.Errorf("Error discarding, got %v cards in hand and crib %v, want 4 and only the dealt crib card",
+			len(game.Players[1].PlayingHand), game.Crib)
+	}
+
+	_, err = game.HumanDiscard(2, game.Players[2].DealtHand[4:])
+	if err != nil {
+		t.Errorf("Error discarding: %v", err)
+		return
+	}
+	if len(game.Crib) != 4 || game.Starter.Name == "" {
+		t.Errorf("Error discarding, got crib %v and starter %v, want 4 cards and a starter", game.Crib, game.Starter)
+	}
+}
+
+func TestScoreShow(t *testing.T) {
+	players := []poner.Player{
+		{Name: "Bob", IsComputer: true, SkillLevel: 4},
+		{Name: "Sue", IsComputer: true, SkillLevel: 4},
+		{Name: "Dan", IsComputer: true, SkillLevel: 4},
+	}
+	game := poner.Game{}
+	game.New(players)
+	_, err := game.NextRound()
+	if err != nil {
+		t.Errorf("Error starting round: %v", err)
+		return
+	}
+	_, err = game.ScoreShow()
+	if err == nil {
+		t.Error("Error scoring show, did not get err for unfinished pegging")
+	}
+	for ii := range game.Players {
+		game.Players[ii].PlayingHand = poner.Hand{}
+	}
+	shows, err := game.ScoreShow()
+	if err != nil {
+		t.Errorf("Error scoring show: %v", err)
+		return
+	}
+	if game.Winner != nil {
+		return
+	}
+	if len(shows) != 4 {
+		t.Errorf("Error scoring show, got %v counts, want 4", len(shows))
+		return
+	}
+	for ii, show := range shows[:3] {
+		if show.Player != (game.Dealer+ii+1)%3 || show.IsCrib {
+			t.Errorf("Error scoring show, got player %v at %v, want %v", show.Player, ii, (game.Dealer+ii+1)%3)
+		}
+	}
+	if !shows[3].IsCrib || shows[3].Player != game.Dealer {
+		t.Errorf("Error scoring show, got %v, want the dealer's crib last", shows[3])
+	}
+}
+
+func TestGameOver(t *testing.T) {
+	players := []poner.Player{
+		{Name: "Bob", IsComputer: true, SkillLevel: 4},
+		{Name: "Sue", IsComputer: true, SkillLevel: 4},
+	}
+	game := poner.Game{ToWin: 15}
+	game.New(players)
+	playUntilOver(t, &game)
+
+	scores := []int{}
+	for _, player := range game.Players {
+		scores = append(scores, player.Score)
+	}
+	_, err := game.Apply(poner.Action{Type: poner.ActionDeal, Player: 0})
+	if err != poner.ErrGameOver {
+		t.Errorf("Error applying action after game over, got %v, want %v", err, poner.ErrGameOver)
+	}
+	_, err = game.NextRound()
+	if err != poner.ErrGameOver {
+		t.Errorf("Error starting round after game over, got %v, want %v", err, poner.ErrGameOver)
+	}
+	_, err = game.PutCardIntoField(game.Starter, &game.Players[0])
+	if err != poner.ErrGameOver {
+		t.Errorf("Error playing card after game over, got %v, want %v", err, poner.ErrGameOver)
+	}
+	game.GoScore()
+	game.ScoreHand(&game.Players[0], false)
+	game.ScoreHand(&game.Players[1], true)
+	for ii, player := range game.Players {
+		if player.Score != scores[ii] {
+			t.Errorf("Error freezing scores after game over, got %v, want %v", player.Score, scores[ii])
+		}
+	}
+}
+
 func playRound(game *poner.Game) (err error) {
 	// Start a new round an get his heels, if drawn
 	_, err = game.NextRound()
@@ -100,12 +324,11 @@ func playRound(game *poner.Game) (err error) {
 	}
 	for ii := range game.Players {
 		if !game.Players[ii].IsComputer {
-			game.Players[ii].Discard = game.Players[ii].DealtHand.GetBestDiscard(&game.Deck, game.Dealer == ii)
-			playingHand := poner.Hand{}
-			for _, card := range game.Players[ii].Discard.Held {
-				playingHand = append(playingHand, card)
+			discard := game.Players[ii].DealtHand.GetBestDiscard(&game.Deck, game.Dealer == ii)
+			_, err = game.HumanDiscard(ii, discard.Discarded)
+			if err != nil {
+				return
 			}
-			game.Players[ii].PlayingHand = playingHand
 		}
 	}
 	// Wait for all players to be out of cards
@@ -125,7 +348,7 @@ func playRound(game *poner.Game) (err error) {
 				if nextPlayer >= len(game.Players) {
 					nextPlayer = 0
 				}
-				bestCard, cantPlay := player.PlayingHand.GetBestPlay(game.Field, game.Players[nextPlayer])
+				bestCard, cantPlay := player.PlayingHand.GetBestPlay(game.Field, game.Players[nextPlayer].Public())
 				if cantPlay {
 					game.HumanPlayGone()
 				} else {
@@ -149,24 +372,6 @@ func playRound(game *poner.Game) (err error) {
 }
 
 func scorePlayerHands(game *poner.Game) {
-	ii := game.Dealer + 1
-	scoresCounted := 0
-	var player *poner.Player
-	// Start with the player left of the dealer and make your way around
-	for scoresCounted < len(game.Players) {
-		if ii >= len(game.Players) {
-			ii = 0
-		}
-		player = &game.Players[ii]
-		// Returns the individual scores and the total value of the hand
-		game.ScoreHand(player, false)
-		// Stop the round if the player has reached 121
-		if game.Winner != nil {
-			return
-		}
-		scoresCounted++
-		ii++
-	}
-	// Count the crib
-	game.ScoreHand(player, true)
+	// Hands are counted left of the dealer around to the dealer, then the crib
+	game.ScoreShow()
 }
@@ -0,0 +1,34 @@
+package poner_test
+
+import (
+	"testing"
+
+	"github.com/blakecallens/poner"
+)
+
+// maxActions stops a test game that never finishes
+const maxActions = 10000
+
+// playUntil applies computer actions to a game until done returns true
+func playUntil(t *testing.T, game *poner.Game, done func(game *poner.Game) bool) {
+	for actions := 0; !done(game); actions++ {
+		if actions > maxActions {
+			t.Fatal("Error applying actions, game did not finish")
+		}
+		action, err := game.ComputerAction()
+		if err != nil {
+			t.Fatalf("Error getting action in %v: %v", game.Phase, err)
+		}
+		_, err = game.Apply(action)
+		if err != nil {
+			t.Fatalf("Error applying %v: %v", action, err)
+		}
+	}
+}
+
+// playUntilOver plays a game with computer actions to the end
+func playUntilOver(t *testing.T, game *poner.Game) {
+	playUntil(t, game, func(game *poner.Game) bool {
+		return game.Phase == poner.PhaseGameOver
+	})
+}
@@ -0,0 +1,269 @@
+package poner
+
+import (
+	"math"
+)
+
+// defaultGreed is how strongly a HandModel expects the opponent to play the cards that peg the most
+const defaultGreed = 1.0
+
+// HandModel is a probability distribution over the cards an opponent is
+// holding, updated from what they are seen to play and when they say go.
+// Pegging only depends on ranks, so hands are tracked by the count of each
+// rank they hold. The opponent is assumed to play each playable card with a
+// chance proportional to e^(Greed × points pegged)
+type HandModel struct {
+	Greed  float64
+	unseen [13]int
+	cards  int
+	hands  []modelHand
+}
+
+// modelHand is a possible hand and its probability
+type modelHand struct {
+	counts [13]int8
+	chance float64
+}
+
+// NewHandModel starts a model of an opponent holding a number of cards drawn
+// evenly from the unseen cards
+func NewHandModel(unseen Hand, cards int) (model *HandModel) {
+	model = &HandModel{Greed: defaultGreed}
+	for _, card := range unseen {
+		model.unseen[card.Order]++
+	}
+	if cards > len(unseen) {
+		cards = len(unseen)
+	}
+	model.cards = cards
+	model.addHands(modelHand{}, 0, cards, 1)
+	model.normalize()
+	return
+}
+
+// addHands adds every hand of the remaining cards from the rank onwards, each
+// weighted by the number of ways its cards could be drawn from the unseen cards
+func (model *HandModel) addHands(hand modelHand, rank int, remaining int, ways float64) {
+	if remaining == 0 {
+		hand.chance = ways
+		model.hands = append(model.hands, hand)
+		return
+	}
+	if rank == len(model.unseen) {
+		return
+	}
+	for count := 0; count <= remaining && count <= model.unseen[rank]; count++ {
+		hand.counts[rank] = int8(count)
+		model.addHands(hand, rank+1, remaining-count, ways*combinations(model.unseen[rank], count))
+	}
+}
+
+// Cards returns how many cards the opponent is holding
+func (model *HandModel) Cards() int {
+	return model.cards
+}
+
+// ObservePlay updates the model with the opponent playing a card onto the field
+func (model *HandModel) ObservePlay(field Hand, card Card) {
+	// The chance of the opponent choosing each rank, before how many they hold
+	preference := [13]float64{}
+	total := field.GetTotal()
+	for rank := range preference {
+		if total+values[rank] > 31 {
+			continue
+		}
+		points := 0
+		played := Card{Name: names[rank], Value: values[rank], Order: rank}
+		for _, score := range played.WouldScore(copyHand(field)) {
+			points += score.Value
+		}
+		preference[rank] = math.Exp(model.Greed * float64(points))
+	}
+
+	rank := card.Order
+	hands := []modelHand{}
+	for _, hand := range model.hands {
+		if hand.counts[rank] == 0 {
+			continue
+		}
+		choices := 0.0
+		for other, count := range hand.counts {
+			choices += float64(count) * preference[other]
+		}
+		if choices == 0 {
+			continue
+		}
+		hand.chance *= float64(hand.counts[rank]) * preference[rank] / choices
+		hand.counts[rank]--
+		hands = append(hands, hand)
+	}
+	model.update(hands)
+	if model.unseen[rank] > 0 {
+		model.unseen[rank]--
+	}
+	if model.cards > 0 {
+		model.cards--
+	}
+}
+
+// ObserveGo updates the model with the opponent saying go at a count's
+// total, which means none of their cards could be played
+func (model *HandModel) ObserveGo(total int) {
+	hands := []modelHand{}
+	for _, hand := range model.hands {
+		canPlay := false
+		for rank, count := range hand.counts {
+			if count > 0 && total+values[rank] <= 31 {
+				canPlay = true
+				break
+			}
+		}
+		if !canPlay {
+			hands = append(hands, hand)
+		}
+	}
+	model.update(hands)
+}
+
+// ObserveCard updates the model with a card seen somewhere other than the opponent's hand
+func (model *HandModel) ObserveCard(card Card) {
+	rank := card.Order
+	if model.unseen[rank] == 0 {
+		return
+	}
+	hands := []modelHand{}
+	for _, hand := range model.hands {
+		if int(hand.counts[rank]) < model.unseen[rank] {
+			hand.chance *= float64(model.unseen[rank]-int(hand.counts[rank])) / float64(model.unseen[rank])
+			hands = append(hands, hand)
+		}
+	}
+	model.update(hands)
+	model.unseen[rank]--
+}
+
+// update replaces the model's hands, keeping the old ones if the observation
+// contradicts every hand
+func (model *HandModel) update(hands []modelHand) {
+	total := 0.0
+	for _, hand := range hands {
+		total += hand.chance
+	}
+	if total == 0 {
+		return
+	}
+	model.hands = hands
+	model.normalize()
+}
+
+// normalize scales the hands' chances to add up to one
+func (model *HandModel) normalize() {
+	total := 0.0
+	for _, hand := range model.hands {
+		total += hand.chance
+	}
+	if total == 0 {
+		return
+	}
+	for ii := range model.hands {
+		model.hands[ii].chance /= total
+	}
+}
+
+// ExpectedRanks returns how many cards of each rank the opponent is expected to hold
+func (model *HandModel) ExpectedRanks() (expected [13]float64) {
+	for _, hand := range model.hands {
+		for rank, count := range hand.counts {
+			expected[rank] += float64(count) * hand.chance
+		}
+	}
+	return
+}
+
+// CardProbability returns the chance the opponent is holding an unseen card
+func (model *HandModel) CardProbability(card Card) float64 {
+	if model.unseen[card.Order] == 0 {
+		return 0
+	}
+	return model.ExpectedRanks()[card.Order] / float64(model.unseen[card.Order])
+}
+
+// CanPlayProbability returns the chance the opponent can play onto a count's total
+func (model *HandModel) CanPlayProbability(total int) float64 {
+	chance := 0.0
+	for _, hand := range model.hands {
+		for rank, count := range hand.counts {
+			if count > 0 && total+values[rank] <= 31 {
+				chance += hand.chance
+				break
+			}
+		}
+	}
+	return chance
+}
+
+// RankWeights returns the relative chance of an unseen card of each rank
+// being in the opponent's hand, for weighting a pegging search
+func (model *HandModel) RankWeights() (weights []float64) {
+	weights = make([]float64, len(model.unseen))
+	expected := model.ExpectedRanks()
+	for rank, unseen := range model.unseen {
+		if unseen > 0 {
+			weights[rank] = expected[rank] / float64(unseen)
+		}
+	}
+	return
+}
+
+// knownHand returns the opponent's hand from the unseen cards when only one
+// hand of ranks is still possible
+func (model *HandModel) knownHand(unseen Hand) (hand Hand, known bool) {
+	possible := []modelHand{}
+	for _, hand := range model.hands {
+		if hand.chance > 0 {
+			possible = append(possible, hand)
+		}
+	}
+	if len(possible) != 1 || model.cards == 0 {
+		return
+	}
+	counts := possible[0].counts
+	hand = Hand{}
+	for _, card := range unseen {
+		if counts[card.Order] > 0 {
+			hand = append(hand, card)
+			counts[card.Order]--
+		}
+	}
+	known = len(hand) == model.cards
+	return
+}
+
+// HandModel builds a model of the next player's hand from the round's pegging so far
+func (view PlayerView) HandModel() (model *HandModel) {
+	opponent := view.NextPlayer()
+	unseen := append(view.Unseen(), opponent.Played...)
+	model = NewHandModel(unseen, opponent.Cards+len(opponent.Played))
+
+	index := view.Index + 1
+	if len(view.Players) > 0 {
+		index %= len(view.Players)
+	}
+	field := Hand{}
+	for _, event := range view.Pegging {
+		switch event.Type {
+		case EventPlay:
+			if event.Player == index {
+				model.ObservePlay(field, event.Card)
+			}
+			field = append(field, event.Card)
+		case EventGo:
+			if event.Player == index {
+				model.ObserveGo(field.GetTotal())
+			}
+		case EventResetField:
+			field = Hand{}
+		}
+	}
+	return
+}
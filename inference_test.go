@@ -0,0 +1,136 @@
+package poner_test
+
+import (
+	"math"
+	"testing"
+
+	"github.com/blakecallens/poner"
+)
+
+func TestHandModelGo(t *testing.T) {
+	deck := poner.Deck{}.New()
+	model := poner.NewHandModel(poner.Hand(deck.Cards), 3)
+	seven, err := deck.PullCards("7h 8h")
+	if err != nil {
+		t.Errorf("Error pulling cards from deck: %v", err)
+		return
+	}
+	prior := model.CardProbability(seven[1])
+	if math.Abs(prior-3.0/52) > 1e-9 {
+		t.Errorf("Error modeling hand, got %v, want %v", prior, 3.0/52)
+	}
+
+	// A go at 24 means no card of 7 or less
+	model.ObserveGo(24)
+	if model.CardProbability(seven[0]) != 0 {
+		t.Errorf("Error observing go, got %v for %v, want 0", model.CardProbability(seven[0]), seven[0])
+	}
+	if model.CardProbability(seven[1]) <= prior {
+		t.Errorf("Error observing go, got %v for %v, want more than %v", model.CardProbability(seven[1]), seven[1], prior)
+	}
+	if model.CanPlayProbability(24) != 0 || math.Abs(model.CanPlayProbability(0)-1) > 1e-9 {
+		t.Error("Error observing go, the opponent should only be able to play on a new count")
+	}
+	expected := 0.0
+	for _, count := range model.ExpectedRanks() {
+		expected += count
+	}
+	if math.Abs(expected-3) > 1e-9 {
+		t.Errorf("Error modeling hand, got %v expected cards, want 3", expected)
+	}
+}
+
+func TestHandModelPlay(t *testing.T) {
+	deck := poner.Deck{}.New()
+	field, err := deck.PullCards("Kc")
+	if err != nil {
+		t.Errorf("Error pulling cards from deck: %v", err)
+		return
+	}
+	played, err := deck.PullCards("2d 2h")
+	if err != nil {
+		t.Errorf("Error pulling cards from deck: %v", err)
+		return
+	}
+	five, err := deck.PullCards("5s")
+	if err != nil {
+		t.Errorf("Error pulling cards from deck: %v", err)
+		return
+	}
+	unseen := append(poner.Hand(deck.Cards), append(copyCards(played), five...)...)
+	model := poner.NewHandModel(unseen, 4)
+	prior := model.CardProbability(five[0])
+	otherTwo := played[1]
+
+	// Playing the 2 instead of making 15 makes holding a 5 less likely
+	model.ObservePlay(field, played[0])
+	if model.Cards() != 3 {
+		t.Errorf("Error observing play, got %v cards, want 3", model.Cards())
+	}
+	if model.CardProbability(otherTwo) >= prior {
+		t.Errorf("Error observing play, got %v for %v, want less than %v", model.CardProbability(otherTwo), otherTwo, prior)
+	}
+	if model.CardProbability(five[0]) >= prior {
+		t.Errorf("Error observing play, got %v for %v, want less than %v", model.CardProbability(five[0]), five[0], prior)
+	}
+	weights := model.RankWeights()
+	if weights[4] >= weights[8] {
+		t.Errorf("Error observing play, got weight %v for fives, want less than %v for nines", weights[4], weights[8])
+	}
+}
+
+// copyCards returns a copy of a hand that can be appended to safely
+func copyCards(hand poner.Hand) poner.Hand {
+	return append(poner.Hand{}, hand...)
+}
+
+func TestPlayViewHandModel(t *testing.T) {
+	deck := poner.Deck{}.New()
+	hand, err := deck.PullCards("3s 4s 9c 10d")
+	if err != nil {
+		t.Errorf("Error pulling cards from deck: %v", err)
+		return
+	}
+	opponentPlayed, err := deck.PullCards("Kh Qh")
+	if err != nil {
+		t.Errorf("Error pulling cards from deck: %v", err)
+		return
+	}
+	starter, err := deck.PullFromTop()
+	if err != nil {
+		t.Errorf("Error pulling cards from deck: %v", err)
+		return
+	}
+	view := poner.PlayerView{
+		Hand:    hand[2:],
+		Dealt:   hand,
+		Played:  hand[:2],
+		Field:   poner.Hand{},
+		Starter: starter,
+		Pegging: []poner.Event{
+			{Type: poner.EventPlay, Player: 1, Card: opponentPlayed[0]},
+			{Type: poner.EventPlay, Player: 0, Card: hand[0]},
+			{Type: poner.EventPlay, Player: 1, Card: opponentPlayed[1]},
+			{Type: poner.EventPlay, Player: 0, Card: hand[1]},
+			{Type: poner.EventGo, Player: 1},
+			{Type: poner.EventResetField},
+		},
+		Players: []poner.PublicPlayer{
+			{Cards: 2, Played: hand[:2]},
+			{Cards: 2, Played: opponentPlayed},
+		},
+		Index:  0,
+		Scores: []int{0, 0},
+	}
+	model := view.HandModel()
+	if model.Cards() != 2 {
+		t.Errorf("Error building hand model, got %v cards, want 2", model.Cards())
+	}
+	// The go at 27 rules out anything below a 5
+	for _, card := range deck.Cards {
+		if card.Value < 5 && model.CardProbability(card) != 0 {
+			t.Errorf("Error building hand model, got %v for %v, want 0", model.CardProbability(card), card)
+			return
+		}
+	}
+}
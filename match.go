@@ -0,0 +1,252 @@
+package poner
+
+import (
+	"errors"
+	"math/rand"
+	"sort"
+)
+
+// Match is a series of games between the same players, scored in game points
+type Match struct {
+	Players           []Player
+	Games             []Game
+	Results           []GameResult
+	Points            []int
+	BestOf            int
+	ToWin             int
+	SkunkLine         int
+	DoubleSkunkLine   int
+	WinPoints         int
+	SkunkPoints       int
+	DoubleSkunkPoints int
+	Teams             bool
+	Rules             Rules
+	Random            *rand.Rand
+}
+
+// GameResult is the outcome of a finished game in a match
+type GameResult struct {
+	Winner      int
+	Scores      []int
+	Skunk       bool
+	DoubleSkunk bool
+	Points      int
+}
+
+// Standing is a player's position in a match
+type Standing struct {
+	Player       int
+	Name         string
+	Points       int
+	GamesWon     int
+	Skunks       int
+	DoubleSkunks int
+}
+
+// SetSeed seeds the match so every game in it can be replayed
+func (match *Match) SetSeed(seed int64) {
+	match.Random = rand.New(rand.NewSource(seed))
+}
+
+// New creates a new match, filling in the standard match rules for anything
+// not set. A negative SkunkLine or DoubleSkunkLine turns that skunk off
+func (match *Match) New(players []Player) {
+	if match.Random == nil {
+		match.Random = newRandom()
+	}
+	if match.BestOf == 0 {
+		match.BestOf = 3
+	}
+	match.Rules = match.Rules.orDefault()
+	if match.ToWin == 0 {
+		match.ToWin = match.Rules.ToWin
+	}
+	skunkLine, doubleSkunkLine := skunkLines(match.ToWin)
+	if match.SkunkLine == 0 {
+		match.SkunkLine = skunkLine
+	}
+	if match.DoubleSkunkLine == 0 {
+		match.DoubleSkunkLine = doubleSkunkLine
+	}
+	if match.WinPoints == 0 {
+		match.WinPoints = 1
+	}
+	if match.SkunkPoints == 0 {
+		match.SkunkPoints = 2
+	}
+	if match.DoubleSkunkPoints == 0 {
+		match.DoubleSkunkPoints = 3
+	}
+
+	match.Players = append([]Player{}, players...)
+	for ii := range match.Players {
+		match.Players[ii].GamesWon = 0
+	}
+	match.Points = make([]int, len(match.Players))
+	match.Games = []Game{}
+	match.Results = []GameResult{}
+}
+
+// skunkLines returns the standard skunk lines for a game to toWin. Games to
+// 121 or more skunk 30 and 60 points short, and shorter games such as
+// five-card's 61 skunk under half way with no double skunk
+func skunkLines(toWin int) (skunkLine int, doubleSkunkLine int) {
+	if toWin < SixCardRules.ToWin {
+		return (toWin + 1) / 2, -1
+	}
+	return toWin - 30, toWin - 60
+}
+
+// CurrentGame returns the game being played, or nil if there isn't one
+func (match *Match) CurrentGame() *Game {
+	if len(match.Games) == 0 || len(match.Games) == len(match.Results) {
+		return nil
+	}
+	return &match.Games[len(match.Games)-1]
+}
+
+// NextGame starts the next game of the match. The first deal of the first
+// game is cut for, after that the loser of the previous game deals first
+func (match *Match) NextGame() (game *Game, err error) {
+	if match.IsOver() {
+		err = errors.New("NextGame:: the match is over")
+		return
+	}
+	if match.CurrentGame() != nil {
+		err = errors.New("NextGame:: the current game has not been finished")
+		return
+	}
+
+	players := []Player{}
+	for _, player := range match.Players {
+		player.Score, player.LastScore = 0, 0
+		player.DealtHand, player.PlayingHand, player.Discard = nil, nil, Discard{}
+		player.Gone = false
+		players = append(players, player)
+	}
+	next := Game{ToWin: match.ToWin, Teams: match.Teams, Rules: match.Rules, Random: match.Random}
+	if len(match.Results) > 0 {
+		err = next.SetFirstDealer(match.loser(match.Results[len(match.Results)-1]))
+		if err != nil {
+			return
+		}
+	}
+	match.Games = append(match.Games, next)
+	game = &match.Games[len(match.Games)-1]
+	game.New(players)
+	return
+}
+
+// FinishGame records the result of the current game once it has a winner
+func (match *Match) FinishGame() (result GameResult, err error) {
+	game := match.CurrentGame()
+	if game == nil {
+		err = errors.New("FinishGame:: there is no game being played")
+		return
+	}
+	if game.Winner == nil {
+		err = errors.New("FinishGame:: the game has no winner")
+		return
+	}
+
+	result.Winner = game.playerIndex(game.Winner)
+	result.Scores = []int{}
+	// Every opponent must be under the line for a skunk. In lowball the
+	// losers have reached the points to win, so the winners must be under it
+	lowball := game.rules().Lowball
+	skunkScore := 0
+	for ii, player := range game.Players {
+		result.Scores = append(result.Scores, player.Score)
+		if (game.Team(ii) == game.Team(result.Winner)) == lowball && player.Score > skunkScore {
+			skunkScore = player.Score
+		}
+	}
+	result.Points = match.WinPoints
+	if skunkScore < match.DoubleSkunkLine {
+		result.DoubleSkunk = true
+		result.Points = match.DoubleSkunkPoints
+	} else if skunkScore < match.SkunkLine {
+		result.Skunk = true
+		result.Points = match.SkunkPoints
+	}
+
+	match.Results = append(match.Results, result)
+	for ii := range match.Players {
+		if game.Team(ii) == game.Team(result.Winner) {
+			match.Points[ii] += result.Points
+			match.Players[ii].GamesWon++
+		}
+	}
+	return
+}
+
+// loser returns the lowest scoring player of a game, or in lowball the
+// highest, who reached the points to win
+func (match *Match) loser(result GameResult) (loser int) {
+	for ii, score := range result.Scores {
+		if match.Rules.Lowball && score > result.Scores[loser] || !match.Rules.Lowball && score < result.Scores[loser] {
+			loser = ii
+		}
+	}
+	return
+}
+
+// IsOver returns whether all games have been played or a side has won a
+// majority of them
+func (match *Match) IsOver() bool {
+	if len(match.Results) >= match.BestOf {
+		return true
+	}
+	for _, player := range match.Players {
+		if player.GamesWon >= match.BestOf/2+1 {
+			return true
+		}
+	}
+	return false
+}
+
+// isPartner returns whether two different players are partners in a team match
+func (match *Match) isPartner(index int, other int) bool {
+	return match.Teams && len(match.Players) == 4 && index != other && index%2 == other%2
+}
+
+// Winner returns the match winner once the match is over
+func (match *Match) Winner() *Player {
+	if !match.IsOver() {
+		return nil
+	}
+	return &match.Players[match.Standings()[0].Player]
+}
+
+// Standings returns the players ordered by games won, then match points
+func (match *Match) Standings() (standings []Standing) {
+	standings = []Standing{}
+	for ii, player := range match.Players {
+		standings = append(standings, Standing{
+			Player:   ii,
+			Name:     player.Name,
+			Points:   match.Points[ii],
+			GamesWon: player.GamesWon,
+		})
+	}
+	for _, result := range match.Results {
+		for ii := range standings {
+			if ii != result.Winner && !match.isPartner(ii, result.Winner) {
+				continue
+			}
+			if result.Skunk {
+				standings[ii].Skunks++
+			}
+			if result.DoubleSkunk {
+				standings[ii].DoubleSkunks++
+			}
+		}
+	}
+	sort.SliceStable(standings, func(ii, jj int) bool {
+		if standings[ii].GamesWon != standings[jj].GamesWon {
+			return standings[ii].GamesWon > standings[jj].GamesWon
+		}
+		return standings[ii].Points > standings[jj].Points
+	})
+	return
+}
@@ -0,0 +1,182 @@
+package poner_test
+
+import (
+	"testing"
+
+	"github.com/blakecallens/poner"
+)
+
+func TestMatch(t *testing.T) {
+	players := []poner.Player{
+		{Name: "Bob", IsComputer: true, SkillLevel: 4},
+		{Name: "Sue", IsComputer: true, SkillLevel: 2},
+	}
+	players[0].GamesWon = 5
+	match := poner.Match{BestOf: 3}
+	match.New(players)
+	if players[0].GamesWon != 5 || match.Players[0].GamesWon != 0 {
+		t.Errorf("Error starting match, got games won %v, want caller's players untouched", players[0].GamesWon)
+	}
+
+	for !match.IsOver() {
+		game, err := match.NextGame()
+		if err != nil {
+			t.Errorf("Error starting game: %v", err)
+			return
+		}
+		if len(match.Results) > 0 {
+			previous := match.Results[len(match.Results)-1]
+			loser := 1 - previous.Winner
+			if (game.Dealer+1)%2 != loser {
+				t.Errorf("Error starting game, got first dealer %v, want loser %v", (game.Dealer+1)%2, loser)
+			}
+			if game.Events[0].Dealer != game.Dealer {
+				t.Errorf("Error recording dealer, got %v, want %v", game.Events[0].Dealer, game.Dealer)
+			}
+			if game.SetFirstDealer(loser) == nil {
+				t.Error("Error setting first dealer, did not get err for started game")
+			}
+		}
+		_, err = match.NextGame()
+		if err == nil {
+			t.Error("Error starting game, did not get err for unfinished game")
+		}
+		playUntilOver(t, game)
+		_, err = match.FinishGame()
+		if err != nil {
+			t.Errorf("Error finishing game: %v", err)
+			return
+		}
+	}
+
+	gamesWon := 0
+	for _, player := range match.Players {
+		gamesWon += player.GamesWon
+	}
+	if gamesWon != len(match.Results) {
+		t.Errorf("Error recording games won, got %v, want %v", gamesWon, len(match.Results))
+	}
+	if len(match.Results) == 3 && match.Results[0].Winner == match.Results[1].Winner {
+		t.Errorf("Error ending match, got third game after %v won two of three", match.Results[0].Winner)
+	}
+	standings := match.Standings()
+	if standings[0].GamesWon != 2 {
+		t.Errorf("Error ending match, got %v games won by the leader, want 2", standings[0].GamesWon)
+	}
+	if match.Winner() == nil || match.Winner().Name != standings[0].Name {
+		t.Errorf("Error getting match winner, got %v, want %v", match.Winner(), standings[0].Name)
+	}
+	_, err := match.NextGame()
+	if err == nil {
+		t.Error("Error starting game, did not get err for finished match")
+	}
+}
+
+func TestMatchSkunks(t *testing.T) {
+	players := []poner.Player{
+		{Name: "Bob", IsComputer: true},
+		{Name: "Sue", IsComputer: true},
+	}
+	match := poner.Match{BestOf: 4}
+	match.New(players)
+
+	losingScores := []int{100, 80, 50}
+	wantPoints := []int{1, 2, 3}
+	for ii, losingScore := range losingScores {
+		game, err := match.NextGame()
+		if err != nil {
+			t.Errorf("Error starting game: %v", err)
+			return
+		}
+		_, err = match.FinishGame()
+		if err == nil {
+			t.Error("Error finishing game, did not get err for game without a winner")
+		}
+		game.Players[0].Score = 121
+		game.Players[1].Score = losingScore
+		game.CheckForWinner(&game.Players[0])
+		result, err := match.FinishGame()
+		if err != nil {
+			t.Errorf("Error finishing game: %v", err)
+			return
+		}
+		if result.Points != wantPoints[ii] {
+			t.Errorf("Error scoring game at %v, got %v points, want %v", losingScore, result.Points, wantPoints[ii])
+		}
+	}
+	standings := match.Standings()
+	if standings[0].Name != "Bob" || standings[0].Points != 6 || standings[0].Skunks != 1 ||
+		standings[0].DoubleSkunks != 1 {
+		t.Errorf("Error getting standings, got %v, want Bob with 6 points", standings[0])
+	}
+	if !match.IsOver() {
+		t.Error("Error checking match, leader has won three of four but match isn't over")
+	}
+}
+
+func TestLowballMatch(t *testing.T) {
+	players := []poner.Player{
+		{Name: "Bob", IsComputer: true},
+		{Name: "Sue", IsComputer: true},
+	}
+	match := poner.Match{BestOf: 3, Rules: poner.LowballRules}
+	match.New(players)
+
+	// Sue reaches 121 and loses each game, skunked while Bob is under the line
+	winningScores := []int{100, 80}
+	wantPoints := []int{1, 2}
+	for ii, winningScore := range winningScores {
+		game, err := match.NextGame()
+		if err != nil {
+			t.Errorf("Error starting game: %v", err)
+			return
+		}
+		if ii > 0 && (game.Dealer+1)%2 != 1 {
+			t.Errorf("Error starting game, got first dealer %v, want the loser 1", (game.Dealer+1)%2)
+		}
+		game.Players[0].Score = winningScore
+		game.Players[1].Score = 121
+		game.CheckForWinner(&game.Players[1])
+		result, err := match.FinishGame()
+		if err != nil {
+			t.Errorf("Error finishing game: %v", err)
+			return
+		}
+		if result.Winner != 0 || result.Points != wantPoints[ii] {
+			t.Errorf("Error scoring lowball game at %v, got winner %v with %v points, want 0 with %v", winningScore, result.Winner, result.Points, wantPoints[ii])
+		}
+	}
+	if !match.IsOver() || match.Winner() == nil || match.Winner().Name != "Bob" {
+		t.Errorf("Error ending lowball match, got winner %v, want Bob", match.Winner())
+	}
+}
+
+func TestMatchSkunkLines(t *testing.T) {
+	players := []poner.Player{
+		{Name: "Bob", IsComputer: true},
+		{Name: "Sue", IsComputer: true},
+	}
+	match := poner.Match{Rules: poner.FiveCardRules}
+	match.New(players)
+	if match.SkunkLine != 31 || match.DoubleSkunkLine >= 0 {
+		t.Errorf("Error starting five-card match, got skunk lines %v and %v, want 31 and none", match.SkunkLine, match.DoubleSkunkLine)
+	}
+
+	match = poner.Match{SkunkLine: -1, DoubleSkunkLine: -1}
+	match.New(players)
+	game, err := match.NextGame()
+	if err != nil {
+		t.Errorf("Error starting game: %v", err)
+		return
+	}
+	game.Players[0].Score = 121
+	game.CheckForWinner(&game.Players[0])
+	result, err := match.FinishGame()
+	if err != nil {
+		t.Errorf("Error finishing game: %v", err)
+		return
+	}
+	if result.Skunk || result.DoubleSkunk || result.Points != 1 {
+		t.Errorf("Error scoring game with skunks off, got %v", result)
+	}
+}
@@ -0,0 +1,116 @@
+package poner
+
+import (
+	"errors"
+	"fmt"
+)
+
+// OverClaim is how a claim of more points than the rules give is handled under muggins
+type OverClaim int
+
+// The ways of handling an over claim
+const (
+	// OverClaimCorrected scores what the rules give
+	OverClaimCorrected OverClaim = iota
+	// OverClaimForfeit scores nothing for the count
+	OverClaimForfeit
+	// OverClaimMuggins scores what the rules give and lets opponents take the excess
+	OverClaimMuggins
+)
+
+var overClaimNames = [...]string{"Corrected", "Forfeit", "Muggins"}
+
+func (overClaim OverClaim) String() string {
+	if overClaim < 0 || int(overClaim) >= len(overClaimNames) {
+		return fmt.Sprintf("OverClaim(%d)", int(overClaim))
+	}
+	return overClaimNames[overClaim]
+}
+
+// MissedScore holds points a human player missed, or over claimed, that
+// opponents can take with a muggins action before the next action
+type MissedScore struct {
+	Player int     `json:"player"`
+	Points int     `json:"points"`
+	Scores []Score `json:"scores"`
+}
+
+// The scores given under muggins
+var (
+	claimedScore = Score{Name: "Claimed"}
+	mugginsScore = Score{Name: "Muggins"}
+)
+
+// claimScores compares the points a human player claimed with the scores
+// the rules give, returning the scores they get. Without muggins, or
+// without an action to claim with, players get every point, so the go and
+// last card points scored when a count ends can't be missed
+func (game *Game) claimScores(index int, scores []Score, action *Action) []Score {
+	if !game.Muggins || action == nil || game.Players[index].IsComputer {
+		return scores
+	}
+	total := scoresTotal(scores)
+	switch {
+	case action.Points == total:
+		return scores
+	case action.Points < total:
+		game.Missed = &MissedScore{Player: index, Points: total - action.Points, Scores: scores}
+		return claimedScores(action.Points)
+	case game.OverClaim == OverClaimForfeit:
+		return []Score{}
+	case game.OverClaim == OverClaimMuggins:
+		game.Missed = &MissedScore{Player: index, Points: action.Points - total, Scores: scores}
+	}
+	return scores
+}
+
+// claimedScores returns the score for claiming fewer points than the rules give
+func claimedScores(points int) []Score {
+	if points == 0 {
+		return []Score{}
+	}
+	claim := claimedScore
+	claim.Value = points
+	return []Score{claim}
+}
+
+// applyMuggins gives missed points to the opponent who called muggins
+func (game *Game) applyMuggins(action Action) (scores []Score, err error) {
+	if !game.Muggins {
+		err = errors.New("Apply:: muggins is not being played")
+		return
+	}
+	if game.Missed == nil {
+		err = errors.New("Apply:: there are no missed points to take")
+		return
+	}
+	if game.Team(action.Player) == game.Team(game.Missed.Player) {
+		err = fmt.Errorf("Apply:: player %v cannot take their own team's missed points", action.Player)
+		return
+	}
+
+	score := mugginsScore
+	score.Value = game.Missed.Points
+	scores = []Score{score}
+	game.Missed = nil
+	player := &game.Players[action.Player]
+	game.addScore(player, scores)
+	game.record(Event{Type: EventMuggins, Player: action.Player, Scores: scores})
+	game.CheckForWinner(player)
+	return
+}
+
+// mugginsCaller returns the first computer opponent after the player with
+// missed points, who always calls muggins, or -1 if there isn't one
+func (game *Game) mugginsCaller() int {
+	if game.Missed == nil || game.Phase == PhaseGameOver {
+		return -1
+	}
+	for step := 1; step < len(game.Players); step++ {
+		index := (game.Missed.Player + step) % len(game.Players)
+		if game.Players[index].IsComputer && !game.IsPartner(index, game.Missed.Player) {
+			return index
+		}
+	}
+	return -1
+}
@@ -0,0 +1,265 @@
+package poner_test
+
+import (
+	"bytes"
+	"reflect"
+	"testing"
+
+	"github.com/blakecallens/poner"
+)
+
+// claimingAction picks an action for a human player, who claims the points
+// given by claim for what the rules give
+func claimingAction(game *poner.Game, index int, claim func(total int) int) (action poner.Action, err error) {
+	action, err = humanAction(game, index)
+	player := game.Players[index]
+	switch action.Type {
+	case poner.ActionPlay:
+		action.Points = claim(totalScore(action.Card.WouldScore(copyCards(game.Field))))
+	case poner.ActionShow:
+		_, total := copyCards(player.Discard.Held).Score(game.Starter, false)
+		action.Points = claim(total)
+	case poner.ActionCrib:
+		_, total := copyCards(game.Crib).Score(game.Starter, true)
+		action.Points = claim(total)
+	}
+	return
+}
+
+// totalScore adds up a list of scores
+func totalScore(scores []poner.Score) (total int) {
+	for _, score := range scores {
+		total += score.Value
+	}
+	return
+}
+
+// nextAction returns the next action of a game, with human players claiming
+// points with claim
+func nextAction(game *poner.Game, claim func(total int) int) (action poner.Action, err error) {
+	action, err = game.ComputerAction()
+	if err == nil {
+		return
+	}
+	for index, player := range game.Players {
+		if !player.IsComputer {
+			action, err = claimingAction(game, index, claim)
+			if err == nil {
+				return
+			}
+		}
+	}
+	return
+}
+
+func TestMuggins(t *testing.T) {
+	players := []poner.Player{
+		{Name: "Bob", IsComputer: true, SkillLevel: 4},
+		{Name: "Sue"},
+	}
+	game := poner.Game{Muggins: true}
+	game.SetSeed(3)
+	game.New(players)
+
+	// Sue never claims anything, so Bob takes her points
+	missed, taken := 0, 0
+	for game.Phase != poner.PhaseGameOver {
+		action, err := nextAction(&game, func(int) int { return 0 })
+		if err != nil {
+			t.Errorf("Error getting action: %v", err)
+			return
+		}
+		if action.Type == poner.ActionMuggins {
+			if action.Player != 0 || game.Missed == nil || game.Missed.Player != 1 {
+				t.Errorf("Error calling muggins, got %v for %v", action, game.Missed)
+				return
+			}
+			missed += game.Missed.Points
+		}
+		scores, err := game.Apply(action)
+		if err != nil {
+			t.Errorf("Error applying %v: %v", action, err)
+			return
+		}
+		if action.Type == poner.ActionMuggins {
+			taken += totalScore(scores)
+		}
+	}
+	if missed == 0 || taken != missed {
+		t.Errorf("Error taking missed points, got %v, want %v", taken, missed)
+	}
+	if game.Winner != &game.Players[0] {
+		t.Errorf("Error playing muggins, got winner %v, want Bob", game.Winner.Name)
+	}
+
+	// Claims and muggins calls are kept in game notation
+	written := bytes.Buffer{}
+	err := game.WriteNotation(&written)
+	if err != nil {
+		t.Errorf("Error writing notation: %v", err)
+		return
+	}
+	events, err := poner.ParseNotation(&written)
+	if err != nil {
+		t.Errorf("Error parsing notation: %v", err)
+		return
+	}
+	replayed, err := poner.Replay(events, len(events))
+	if err != nil || replayed.Players[1].Score != game.Players[1].Score || !replayed.Muggins {
+		t.Errorf("Error replaying muggins, got score %v, want %v, %v", replayed.Players[1].Score,
+			game.Players[1].Score, err)
+	}
+}
+
+func TestOverClaim(t *testing.T) {
+	tests := []struct {
+		overClaim poner.OverClaim
+		scored    func(total int) int
+		missed    func(total int) int
+	}{
+		{poner.OverClaimCorrected, func(total int) int { return total }, func(int) int { return 0 }},
+		{poner.OverClaimForfeit, func(int) int { return 0 }, func(int) int { return 0 }},
+		{poner.OverClaimMuggins, func(total int) int { return total }, func(int) int { return 5 }},
+	}
+	for _, test := range tests {
+		players := []poner.Player{{Name: "Bob"}, {Name: "Sue"}}
+		game := poner.Game{Muggins: true, OverClaim: test.overClaim}
+		game.SetSeed(7)
+		game.New(players)
+		for game.Phase != poner.PhaseShow {
+			action, err := nextAction(&game, func(total int) int { return total })
+			if err != nil {
+				t.Errorf("Error getting action: %v", err)
+				return
+			}
+			_, err = game.Apply(action)
+			if err != nil {
+				t.Errorf("Error applying %v: %v", action, err)
+				return
+			}
+		}
+
+		// Claim five too many for the first hand in the show
+		index := game.PlayerToAct()
+		before := game.Players[index].Score
+		action, err := claimingAction(&game, index, func(total int) int { return total + 5 })
+		if err != nil {
+			t.Errorf("Error getting action: %v", err)
+			return
+		}
+		total := action.Points - 5
+		_, err = game.Apply(action)
+		if err != nil {
+			t.Errorf("Error applying %v: %v", action, err)
+			return
+		}
+		if game.Players[index].Score-before != test.scored(total) {
+			t.Errorf("Error over claiming with %v, got %v points, want %v", test.overClaim,
+				game.Players[index].Score-before, test.scored(total))
+		}
+		missed := 0
+		if game.Missed != nil {
+			missed = game.Missed.Points
+		}
+		if missed != test.missed(total) {
+			t.Errorf("Error over claiming with %v, got %v missed, want %v", test.overClaim, missed, test.missed(total))
+		}
+		if game.Missed == nil {
+			continue
+		}
+
+		_, err = game.Apply(poner.Action{Type: poner.ActionMuggins, Player: index})
+		if err == nil {
+			t.Error("Error calling muggins, a player took their own points")
+		}
+		// Muggins has to be called before the next action
+		action, _ = claimingAction(&game, game.PlayerToAct(), func(total int) int { return total })
+		_, err = game.Apply(action)
+		if err != nil || game.Missed != nil {
+			t.Errorf("Error applying %v, got missed %v, %v", action, game.Missed, err)
+		}
+		_, err = game.Apply(poner.Action{Type: poner.ActionMuggins, Player: 1 - index})
+		if err == nil {
+			t.Error("Error calling muggins, points were taken after the next action")
+		}
+	}
+}
+
+func TestMugginsOff(t *testing.T) {
+	game := poner.Game{}
+	game.New([]poner.Player{{Name: "Bob"}, {Name: "Sue"}})
+	_, err := game.Apply(poner.Action{Type: poner.ActionMuggins, Player: 0})
+	if err == nil {
+		t.Error("Error calling muggins, want an error when muggins isn't played")
+	}
+}
+
+func TestMugginsReplay(t *testing.T) {
+	players := []poner.Player{{Name: "Bob"}, {Name: "Sue"}}
+	game := poner.Game{Muggins: true, OverClaim: poner.OverClaimMuggins}
+	game.SetSeed(7)
+	game.New(players)
+	for game.Phase != poner.PhaseShow {
+		action, err := nextAction(&game, func(total int) int { return total })
+		if err != nil {
+			t.Errorf("Error getting action: %v", err)
+			return
+		}
+		_, err = game.Apply(action)
+		if err != nil {
+			t.Errorf("Error applying %v: %v", action, err)
+			return
+		}
+	}
+	index := game.PlayerToAct()
+	action, err := claimingAction(&game, index, func(total int) int { return total + 5 })
+	if err != nil {
+		t.Errorf("Error getting action: %v", err)
+		return
+	}
+	_, err = game.Apply(action)
+	if err != nil || game.Missed == nil {
+		t.Errorf("Error applying %v, got missed %v, %v", action, game.Missed, err)
+		return
+	}
+
+	// The over claim rule and the points waiting to be taken are replayed
+	replayed, err := poner.Replay(game.Events, len(game.Events))
+	if err != nil {
+		t.Errorf("Error replaying game: %v", err)
+		return
+	}
+	if replayed.OverClaim != poner.OverClaimMuggins || !reflect.DeepEqual(replayed.Missed, game.Missed) {
+		t.Errorf("Error replaying muggins, got %v and missed %v, want %v and %v", replayed.OverClaim,
+			replayed.Missed, game.OverClaim, game.Missed)
+	}
+	before := replayed.Players[1-index].Score
+	scores, err := replayed.Apply(poner.Action{Type: poner.ActionMuggins, Player: 1 - index})
+	if err != nil || totalScore(scores) != 5 || replayed.Players[1-index].Score != before+5 {
+		t.Errorf("Error calling muggins on a replayed game, got %v, %v", scores, err)
+	}
+
+	// Replaying past the next action drops the missed points
+	action, _ = claimingAction(&game, game.PlayerToAct(), func(total int) int { return total })
+	_, err = game.Apply(action)
+	if err != nil {
+		t.Errorf("Error applying %v: %v", action, err)
+		return
+	}
+	replayed, err = poner.Replay(game.Events, len(game.Events))
+	if err != nil || replayed.Missed != nil {
+		t.Errorf("Error replaying game, got missed %v, %v", replayed.Missed, err)
+	}
+
+	// The over claim rule is kept in game notation
+	written := bytes.Buffer{}
+	err = game.WriteNotation(&written)
+	if err != nil {
+		t.Errorf("Error writing notation: %v", err)
+		return
+	}
+	events, err := poner.ParseNotation(&written)
+	if err != nil || events[0].OverClaim != poner.OverClaimMuggins {
+		t.Errorf("Error parsing notation, got %v, %v", events[0].OverClaim, err)
+	}
+}
@@ -0,0 +1,982 @@
+package poner
+
+import (
+	"bufio"
+	"fmt"
+	"io"
+	"strconv"
+	"strings"
+)
+
+// Game notation is a line based record of a game, written from its event log:
+//
+//	[Players "Bob" "Sue"]
+//	[ToWin "121"]
+//
+//	Round 1 dealer Sue
+//	Hand Bob 5H 6H 7C 8D JS KH
+//	Hand Sue AC 2D 3S 4H 9C QD
+//	Discard Bob JS KH
+//	Discard Sue 9C QD
+//	Starter 5D
+//	Play Bob 5H 5
+//	Play Sue 3S 8
+//	Play Bob 7C 15 for 2
+//	Play Sue AC 16
+//	Play Bob 6H 22
+//	Play Sue 2D 24
+//	Go Bob 24
+//	Play Sue 4H 28
+//	Last Sue for 1
+//	Reset
+//	Play Bob 8D 8
+//	Last Bob for 1
+//	Reset
+//	Show Bob 5H 6H 7C 8D for 12
+//	Show Sue AC 2D 3S 4H for 7
+//	Crib Sue JS KH 9C QD for 9
+//
+// Play and go lines give the running total, scoring lines claim points
+// with "for", and a finished game ends with a Winner line. Names with spaces
+// are quoted, and anything after a ; is a comment. Tags such as
+// [Teams "true"], [Muggins "true"], [OverClaim "Forfeit"] and
+// [Variant "Five-Card"] record how the game is played, and Heels, Three and Muggins lines record points scored outside
+// the pegging and the show. Under muggins a Missed line follows a claim that
+// left points for the opponents to take
+
+// WriteNotation writes the game's event log in game notation
+func (game *Game) WriteNotation(writer io.Writer) (err error) {
+	if len(game.Events) == 0 || game.Events[0].Type != EventNewGame {
+		return fmt.Errorf("WriteNotation:: the event log doesn't start with a new game")
+	}
+	names := []string{}
+	for _, player := range game.Events[0].Players {
+		if player.Name == "" || strings.ContainsAny(player.Name, "\n\r") || notationContains(names, player.Name) {
+			return fmt.Errorf("WriteNotation:: players need different names, got %q", player.Name)
+		}
+		names = append(names, player.Name)
+	}
+
+	builder := strings.Builder{}
+	builder.WriteString("[Players")
+	for _, name := range names {
+		builder.WriteString(" " + strconv.Quote(name))
+	}
+	fmt.Fprintf(&builder, "]\n[ToWin %q]\n", strconv.Itoa(game.Events[0].ToWin))
+	if game.Events[0].Teams {
+		builder.WriteString("[Teams \"true\"]\n")
+	}
+	err = writeNotationRules(&builder, game.Events[0].Rules)
+	if err != nil {
+		return fmt.Errorf("WriteNotation:: %v", err)
+	}
+	if game.Events[0].Muggins {
+		builder.WriteString("[Muggins \"true\"]\n")
+		if game.Events[0].OverClaim != OverClaimCorrected {
+			fmt.Fprintf(&builder, "[OverClaim %q]\n", game.Events[0].OverClaim.String())
+		}
+	}
+
+	for _, event := range game.Events[1:] {
+		if event.Player < 0 || event.Player >= len(names) {
+			return fmt.Errorf("WriteNotation:: %v event has invalid player %v", event.Type, event.Player)
+		}
+		name := notationName(names[event.Player])
+		switch event.Type {
+		case EventDeal:
+			fmt.Fprintf(&builder, "\nRound %v dealer %v", event.Round, name)
+			if event.Card != (Card{}) {
+				builder.WriteString(" crib " + event.Card.Code())
+			}
+			builder.WriteString("\n")
+			for index, hand := range event.Hands {
+				fmt.Fprintf(&builder, "Hand %v %v\n", notationName(names[index]), notationCards(hand))
+			}
+		case EventDiscard:
+			fmt.Fprintf(&builder, "Discard %v %v\n", name, notationCards(event.Cards))
+		case EventStarter:
+			fmt.Fprintf(&builder, "Starter %v\n", event.Card.Code())
+		case EventHisHeels:
+			fmt.Fprintf(&builder, "Heels %v for %v\n", name, scoresTotal(event.Scores))
+		case EventPlay:
+			fmt.Fprintf(&builder, "Play %v %v %v", name, event.Card.Code(), event.Total)
+			if points := scoresTotal(event.Scores); points > 0 {
+				fmt.Fprintf(&builder, " for %v", points)
+			}
+			builder.WriteString("\n")
+		case EventGo:
+			fmt.Fprintf(&builder, "Go %v %v\n", name, event.Total)
+		case EventGoScore:
+			fmt.Fprintf(&builder, "Last %v for %v\n", name, scoresTotal(event.Scores))
+		case EventResetField:
+			builder.WriteString("Reset\n")
+		case EventShow:
+			fmt.Fprintf(&builder, "Show %v %v for %v\n", name, notationCards(event.Cards), scoresTotal(event.Scores))
+		case EventCrib:
+			fmt.Fprintf(&builder, "Crib %v %v for %v\n", name, notationCards(event.Cards), scoresTotal(event.Scores))
+		case EventMuggins:
+			fmt.Fprintf(&builder, "Muggins %v for %v\n", name, scoresTotal(event.Scores))
+		case EventThreeForLast:
+			fmt.Fprintf(&builder, "Three %v for %v\n", name, scoresTotal(event.Scores))
+		case EventMissed:
+			fmt.Fprintf(&builder, "Missed %v for %v\n", name, event.Total)
+		default:
+			return fmt.Errorf("WriteNotation:: unexpected %v event", event.Type)
+		}
+	}
+	if game.Winner != nil {
+		fmt.Fprintf(&builder, "\nWinner %v\n", notationName(game.Winner.Name))
+	}
+
+	_, err = io.WriteString(writer, builder.String())
+	if err != nil {
+		err = fmt.Errorf("WriteNotation:: %v", err)
+	}
+	return
+}
+
+// ParseNotation reads a game written in game notation and returns its event
+// log, for use with Replay. Every claimed score and running total is checked
+// against the rules. Notation doesn't record the order of the undealt cards,
+// so the deck after each deal is the rest of the cards in new deck order
+func ParseNotation(reader io.Reader) (events []Event, err error) {
+	parser := notationParser{}
+	scanner := bufio.NewScanner(reader)
+	for line := 1; scanner.Scan(); line++ {
+		var tokens []string
+		tokens, err = notationTokens(scanner.Text())
+		if err == nil && len(tokens) > 0 {
+			err = parser.parseLine(tokens)
+		}
+		if err != nil {
+			err = fmt.Errorf("ParseNotation:: line %v: %v", line, err)
+			return
+		}
+	}
+	err = scanner.Err()
+	if err == nil {
+		err = parser.finish()
+	}
+	if err != nil {
+		err = fmt.Errorf("ParseNotation:: %v", err)
+		return
+	}
+	events = parser.events
+	return
+}
+
+// notationParser rebuilds a game line by line to check each event against it
+type notationParser struct {
+	game      Game
+	events    []Event
+	toWin     int
+	deal      *Event
+	winner    string
+	started   bool
+	teams     bool
+	rules     *Rules
+	muggins   bool
+	overClaim OverClaim
+	claimed   *MissedScore
+	threeOwed bool
+	lastTaken bool
+}
+
+// parseLine parses one line of notation
+func (parser *notationParser) parseLine(tokens []string) (err error) {
+	if strings.HasPrefix(tokens[0], "[") {
+		return parser.parseTag(tokens)
+	}
+	if !parser.started {
+		err = parser.start()
+		if err != nil {
+			return
+		}
+	}
+	if parser.winner != "" {
+		return fmt.Errorf("unexpected %v after the winner", tokens[0])
+	}
+	// Games played without Apply can reset the field after the winning peg
+	if parser.game.Winner != nil && tokens[0] != "Winner" && tokens[0] != "Reset" {
+		return fmt.Errorf("unexpected %v, %v has already won", tokens[0], parser.game.Winner.Name)
+	}
+	if parser.deal != nil && tokens[0] != "Hand" {
+		return fmt.Errorf("round %v has %v of %v hands", parser.deal.Round, parser.dealtHands(), len(parser.game.Players))
+	}
+	if parser.threeOwed && tokens[0] != "Three" {
+		return fmt.Errorf("unexpected %v, want the three for last", tokens[0])
+	}
+
+	tokens, points, claimed, err := notationClaim(tokens)
+	if err != nil {
+		return
+	}
+	// Points missed by a claim are written after the rest of its action
+	if tokens[0] != "Missed" && tokens[0] != "Last" && tokens[0] != "Reset" {
+		parser.claimed = nil
+	}
+	switch tokens[0] {
+	case "Round":
+		return parser.parseRound(tokens)
+	case "Hand":
+		return parser.parseHand(tokens)
+	case "Discard":
+		return parser.parseDiscard(tokens)
+	case "Starter":
+		return parser.parseStarter(tokens)
+	case "Three":
+		return parser.parseThree(tokens, points, claimed)
+	case "Heels":
+		return parser.parseHeels(tokens, points, claimed)
+	case "Play":
+		return parser.parsePlay(tokens, points)
+	case "Go":
+		return parser.parseGo(tokens)
+	case "Last":
+		return parser.parseLast(tokens, points, claimed)
+	case "Reset":
+		if len(tokens) != 1 {
+			return fmt.Errorf("invalid reset %v", tokens)
+		}
+		parser.lastTaken = false
+		return parser.apply(Event{Type: EventResetField})
+	case "Show", "Crib":
+		return parser.parseShow(tokens, points, claimed)
+	case "Missed":
+		return parser.parseMissed(tokens, points, claimed)
+	case "Muggins":
+		return parser.parseMuggins(tokens, points, claimed)
+	case "Winner":
+		return parser.parseWinner(tokens)
+	}
+	return fmt.Errorf("unknown line %v", tokens[0])
+}
+
+// parseTag parses a [Name "value"...] tag
+func (parser *notationParser) parseTag(tokens []string) (err error) {
+	last := len(tokens) - 1
+	if parser.started || !strings.HasSuffix(tokens[last], "]") {
+		return fmt.Errorf("invalid tag %v", tokens)
+	}
+	tokens[0] = tokens[0][1:]
+	tokens[last] = strings.TrimSuffix(tokens[last], "]")
+	if tokens[last] == "" {
+		tokens = tokens[:last]
+	}
+	switch tokens[0] {
+	case "Players":
+		if len(tokens) < 3 {
+			return fmt.Errorf("a game needs at least 2 players")
+		}
+		parser.game.Players = []Player{}
+		for _, name := range tokens[1:] {
+			if _, found := parser.playerIndex(name); found {
+				return fmt.Errorf("duplicate player %v", name)
+			}
+			parser.game.Players = append(parser.game.Players, Player{Name: name})
+		}
+	case "ToWin":
+		if len(tokens) != 2 {
+			return fmt.Errorf("invalid tag %v", tokens)
+		}
+		parser.toWin, err = strconv.Atoi(tokens[1])
+		if err != nil || parser.toWin <= 0 {
+			return fmt.Errorf("invalid points to win %v", tokens[1])
+		}
+	case "Teams", "Muggins":
+		if len(tokens) != 2 || (tokens[1] != "true" && tokens[1] != "false") {
+			return fmt.Errorf("invalid tag %v", tokens)
+		}
+		if tokens[0] == "Teams" {
+			parser.teams = tokens[1] == "true"
+		} else {
+			parser.muggins = tokens[1] == "true"
+		}
+	case "OverClaim":
+		if len(tokens) != 2 || parser.overClaim.UnmarshalText([]byte(tokens[1])) != nil {
+			return fmt.Errorf("invalid tag %v", tokens)
+		}
+	default:
+		return parser.parseRulesTag(tokens)
+	}
+	// Other tags, such as the event or annotator, are only for readers
+	return
+}
+
+// start begins the game once the tags have been read
+func (parser *notationParser) start() error {
+	if len(parser.game.Players) == 0 {
+		return fmt.Errorf("missing Players tag")
+	}
+	rules := SixCardRules
+	if parser.rules != nil {
+		rules = *parser.rules
+	}
+	if parser.toWin == 0 {
+		parser.toWin = rules.ToWin
+	}
+	parser.started = true
+	players := append([]Player{}, parser.game.Players...)
+	return parser.apply(Event{Type: EventNewGame, Players: players, ToWin: parser.toWin, Muggins: parser.muggins,
+		OverClaim: parser.overClaim, Teams: parser.teams, Rules: &rules})
+}
+
+// parseRulesTag parses a Variant tag or a tag changing one of its rules
+func (parser *notationParser) parseRulesTag(tokens []string) error {
+	if len(tokens) != 2 {
+		return fmt.Errorf("invalid tag %v", tokens)
+	}
+	if tokens[0] == "Variant" {
+		if parser.rules != nil {
+			return fmt.Errorf("the Variant tag has to come before the rules it changes")
+		}
+		rules, found := PresetRules(tokens[1])
+		if !found {
+			return fmt.Errorf("unknown variant %v", tokens[1])
+		}
+		parser.rules = &rules
+		return nil
+	}
+	for _, rule := range notationRules {
+		if rule.tag != tokens[0] {
+			continue
+		}
+		if parser.rules == nil {
+			rules := SixCardRules
+			parser.rules = &rules
+		}
+		var err error
+		switch field := rule.field(parser.rules).(type) {
+		case *int:
+			*field, err = strconv.Atoi(tokens[1])
+		case *bool:
+			*field, err = strconv.ParseBool(tokens[1])
+		}
+		if err != nil {
+			return fmt.Errorf("invalid %v rule %v", tokens[0], tokens[1])
+		}
+		return nil
+	}
+	// Other tags, such as the event or annotator, are only for readers
+	return nil
+}
+
+// parseRound starts a deal, which is applied once every hand is read
+func (parser *notationParser) parseRound(tokens []string) error {
+	if (len(tokens) != 4 && len(tokens) != 6) || tokens[2] != "dealer" ||
+		(len(tokens) == 6 && tokens[4] != "crib") {
+		return fmt.Errorf("invalid round %v", tokens)
+	}
+	round, err := strconv.Atoi(tokens[1])
+	if err != nil || round != parser.game.Round+1 {
+		return fmt.Errorf("got round %v, want %v", tokens[1], parser.game.Round+1)
+	}
+	if parser.game.Phase != PhaseDeal {
+		return fmt.Errorf("round %v started during %v", round, parser.game.Phase)
+	}
+	dealer, err := parser.player(tokens[3])
+	if err != nil {
+		return err
+	}
+	if round == 1 {
+		// The new game event holds the dealer before the first deal
+		parser.events[0].Dealer = (dealer + len(parser.game.Players) - 1) % len(parser.game.Players)
+	}
+	deal := Event{Type: EventDeal, Round: round, Player: dealer, Dealer: dealer,
+		Hands: make([]Hand, len(parser.game.Players))}
+	if (len(tokens) == 6) != (parser.game.cribCards() > 0) {
+		return fmt.Errorf("round %v needs %v cards dealt to the crib", round, parser.game.cribCards())
+	}
+	if len(tokens) == 6 {
+		cards, err := parseNotationCards(tokens[5:])
+		if err != nil {
+			return err
+		}
+		deal.Card = cards[0]
+	}
+	parser.deal = &deal
+	return nil
+}
+
+// parseHand adds a player's dealt hand to the deal
+func (parser *notationParser) parseHand(tokens []string) (err error) {
+	if parser.deal == nil || len(tokens) < 2 {
+		return fmt.Errorf("unexpected hand %v", tokens)
+	}
+	index, err := parser.player(tokens[1])
+	if err != nil {
+		return
+	}
+	if parser.deal.Hands[index] != nil {
+		return fmt.Errorf("%v was dealt twice", tokens[1])
+	}
+	parser.deal.Hands[index], err = parseNotationCards(tokens[2:])
+	if err != nil || parser.dealtHands() < len(parser.game.Players) {
+		return
+	}
+
+	deal := *parser.deal
+	parser.deal = nil
+	deck := Deck{}.New()
+	for _, hand := range deal.Hands {
+		if len(hand) != len(deal.Hands[0]) {
+			return fmt.Errorf("round %v has hands of different sizes", deal.Round)
+		}
+		for _, card := range hand {
+			if !Hand(deck.Cards).Contains(card) {
+				return fmt.Errorf("%v was dealt twice", card.Code())
+			}
+			deck.Cards = Hand(deck.Cards).RemoveCard(card)
+		}
+	}
+	if deal.Card != (Card{}) {
+		if !Hand(deck.Cards).Contains(deal.Card) {
+			return fmt.Errorf("%v was dealt twice", deal.Card.Code())
+		}
+		deck.Cards = Hand(deck.Cards).RemoveCard(deal.Card)
+	}
+	deal.Cards = deck.Cards
+	err = parser.apply(deal)
+	parser.threeOwed = err == nil && parser.game.rules().PoneStart > 0 && deal.Round == 1
+	return
+}
+
+// dealtHands returns how many hands of the current deal have been read
+func (parser *notationParser) dealtHands() (dealt int) {
+	for _, hand := range parser.deal.Hands {
+		if hand != nil {
+			dealt++
+		}
+	}
+	return
+}
+
+// parseDiscard checks a player's discard comes from their dealt hand
+func (parser *notationParser) parseDiscard(tokens []string) (err error) {
+	if len(tokens) < 2 || parser.game.Phase != PhaseDiscard {
+		return fmt.Errorf("unexpected discard %v", tokens)
+	}
+	index, err := parser.player(tokens[1])
+	if err != nil {
+		return
+	}
+	cards, err := parseNotationCards(tokens[2:])
+	if err != nil {
+		return
+	}
+	player := &parser.game.Players[index]
+	if len(player.Discard.Held) > 0 {
+		return fmt.Errorf("%v has already discarded", player.Name)
+	}
+	if len(cards) != parser.game.DiscardCount() {
+		return fmt.Errorf("%v discarded %v cards, want %v", player.Name, len(cards), parser.game.DiscardCount())
+	}
+	if !notationHas(player.DealtHand, cards) {
+		return fmt.Errorf("%v discarded %v, which wasn't dealt to them", player.Name, notationCards(cards))
+	}
+	return parser.apply(Event{Type: EventDiscard, Player: index, Cards: cards})
+}
+
+// parseStarter builds the crib from the discards and any dealt crib card and
+// turns the starter
+func (parser *notationParser) parseStarter(tokens []string) (err error) {
+	game := &parser.game
+	if len(tokens) != 2 || game.Phase != PhaseDiscard || !game.AllPlayersDiscarded() {
+		return fmt.Errorf("unexpected starter %v", tokens)
+	}
+	cards, err := parseNotationCards(tokens[1:2])
+	if err != nil {
+		return
+	}
+	crib := Hand{}
+	for _, player := range game.Players {
+		crib = append(crib, player.Discard.Discarded...)
+	}
+	crib = append(crib, game.Crib...)
+	if len(crib) != game.rules().CribSize {
+		return fmt.Errorf("the crib has %v cards, want %v", len(crib), game.rules().CribSize)
+	}
+	if !notationHas(game.Deck.Cards, cards) {
+		return fmt.Errorf("%v was already dealt", cards[0].Code())
+	}
+	return parser.apply(Event{Type: EventStarter, Player: game.Dealer, Card: cards[0], Cards: crib})
+}
+
+// parseThree checks the first pone's three for last in a five-card game
+func (parser *notationParser) parseThree(tokens []string, points int, claimed bool) (err error) {
+	game := &parser.game
+	if len(tokens) != 2 || !parser.threeOwed {
+		return fmt.Errorf("unexpected three for last %v", tokens)
+	}
+	index, err := parser.player(tokens[1])
+	if err != nil {
+		return
+	}
+	if index != game.nextIndex(game.Dealer) {
+		return fmt.Errorf("%v isn't the pone", tokens[1])
+	}
+	score := game.rules().PoneStartScore()
+	err = checkNotationClaim(points, claimed, []Score{score})
+	if err != nil {
+		return
+	}
+	parser.threeOwed = false
+	return parser.apply(Event{Type: EventThreeForLast, Player: index, Scores: []Score{score}})
+}
+
+// parseHeels checks the dealer's claim for turning a jack
+func (parser *notationParser) parseHeels(tokens []string, points int, claimed bool) (err error) {
+	game := &parser.game
+	if len(tokens) != 2 || game.Phase != PhasePegging {
+		return fmt.Errorf("unexpected heels %v", tokens)
+	}
+	index, err := parser.player(tokens[1])
+	if err != nil {
+		return
+	}
+	score := game.rules().HisHeelsScore(game.Starter)
+	if index != game.Dealer || score.Value == 0 {
+		return fmt.Errorf("%v can't score his heels", tokens[1])
+	}
+	err = checkNotationClaim(points, claimed, []Score{score})
+	if err != nil {
+		return
+	}
+	return parser.apply(Event{Type: EventHisHeels, Player: index, Scores: []Score{score}})
+}
+
+// parsePlay checks a card played into the field, its running total and its points
+func (parser *notationParser) parsePlay(tokens []string, points int) (err error) {
+	game := &parser.game
+	if len(tokens) != 4 || game.Phase != PhasePegging {
+		return fmt.Errorf("unexpected play %v", tokens)
+	}
+	index, err := parser.player(tokens[1])
+	if err != nil {
+		return
+	}
+	err = parser.checkTurn(index)
+	if err != nil {
+		return
+	}
+	cards, err := parseNotationCards(tokens[2:3])
+	if err != nil {
+		return
+	}
+	if !game.Players[index].PlayingHand.Contains(cards[0]) {
+		return fmt.Errorf("%v isn't holding %v", tokens[1], tokens[2])
+	}
+	field, scores, err := game.rules().Play(game.Field, cards[0])
+	if err != nil {
+		return
+	}
+	err = checkNotationTotal(tokens[3], field.GetTotal())
+	if err != nil {
+		return
+	}
+	scores, err = parser.claim(index, points, true, scores)
+	if err != nil {
+		return
+	}
+	return parser.apply(Event{Type: EventPlay, Player: index, Card: cards[0], Scores: scores, Total: field.GetTotal()})
+}
+
+// parseGo checks a player calling go can't play on the running total
+func (parser *notationParser) parseGo(tokens []string) (err error) {
+	game := &parser.game
+	if len(tokens) != 3 || game.Phase != PhasePegging {
+		return fmt.Errorf("unexpected go %v", tokens)
+	}
+	index, err := parser.player(tokens[1])
+	if err != nil {
+		return
+	}
+	err = parser.checkTurn(index)
+	if err != nil {
+		return
+	}
+	err = checkNotationTotal(tokens[2], game.Field.GetTotal())
+	if err != nil {
+		return
+	}
+	if game.rules().CanPlay(game.Players[index].PlayingHand, game.Field) {
+		return fmt.Errorf("%v called go holding a playable card", tokens[1])
+	}
+	return parser.apply(Event{Type: EventGo, Player: index, Total: game.Field.GetTotal()})
+}
+
+// parseLast checks the point for the last card of a count
+func (parser *notationParser) parseLast(tokens []string, points int, claimed bool) (err error) {
+	game := &parser.game
+	if len(tokens) != 2 || game.Phase != PhasePegging || len(game.Field) == 0 ||
+		game.Field.GetTotal() == game.rules().PegLimit || parser.lastTaken {
+		return fmt.Errorf("unexpected last card %v", tokens)
+	}
+	index, err := parser.player(tokens[1])
+	if err != nil {
+		return
+	}
+	if index != game.LastPlayer {
+		return fmt.Errorf("%v didn't play the last card, %v did", tokens[1], game.Players[game.LastPlayer].Name)
+	}
+	score := goScore.AddPairing(game.Field)
+	err = checkNotationClaim(points, claimed, []Score{score})
+	if err != nil {
+		return
+	}
+	parser.lastTaken = true
+	return parser.apply(Event{Type: EventGoScore, Player: index, Scores: []Score{score}})
+}
+
+// checkTurn returns an error if it isn't the player's turn to peg
+func (parser *notationParser) checkTurn(index int) error {
+	game := &parser.game
+	if index != game.ActivePlayer {
+		return fmt.Errorf("%v pegged out of turn, want %v", game.Players[index].Name,
+			game.Players[game.ActivePlayer].Name)
+	}
+	return nil
+}
+
+// parseShow checks the count of a hand or the crib
+func (parser *notationParser) parseShow(tokens []string, points int, claimed bool) (err error) {
+	game := &parser.game
+	if len(tokens) < 2 || game.Phase < PhasePegging || game.Phase > PhaseCrib || !game.AllPlaysDone() {
+		return fmt.Errorf("unexpected %v %v", tokens[0], tokens)
+	}
+	index, err := parser.player(tokens[1])
+	if err != nil {
+		return
+	}
+	cards, err := parseNotationCards(tokens[2:])
+	if err != nil {
+		return
+	}
+	isCrib := tokens[0] == "Crib"
+	hand, event := game.Players[index].Discard.Held, Event{Type: EventShow, Player: index}
+	if isCrib {
+		if index != game.Dealer {
+			return fmt.Errorf("%v doesn't have the crib", tokens[1])
+		}
+		hand, event.Type = game.Crib, EventCrib
+	}
+	if len(cards) != len(hand) || !notationHas(hand, cards) {
+		return fmt.Errorf("%v showed %v, want %v", tokens[1], notationCards(cards), notationCards(hand))
+	}
+	scores, total := game.rules().Score(hand, game.Starter, isCrib)
+	scores, err = parser.claim(index, points, claimed, scores)
+	if err != nil {
+		return
+	}
+	event.Cards, event.Scores, event.Total = hand, scores, total
+	return parser.apply(event)
+}
+
+// parseMissed checks the points a player's last claim left for the opponents
+// to take. Claiming less than the rules give misses the difference, and under
+// OverClaimMuggins a claim of the rules' points can have been an over claim
+func (parser *notationParser) parseMissed(tokens []string, points int, claimed bool) (err error) {
+	if len(tokens) != 2 || !parser.muggins || !claimed || points == 0 || parser.claimed == nil {
+		return fmt.Errorf("unexpected missed points %v", tokens)
+	}
+	index, err := parser.player(tokens[1])
+	if err != nil {
+		return
+	}
+	missed := parser.claimed
+	parser.claimed = nil
+	if index != missed.Player {
+		return fmt.Errorf("%v didn't make the last claim, %v did", tokens[1], parser.game.Players[missed.Player].Name)
+	}
+	if missed.Points > 0 && points != missed.Points {
+		return fmt.Errorf("%v missed %v points, not %v", tokens[1], missed.Points, points)
+	}
+	if missed.Points == 0 && parser.overClaim != OverClaimMuggins {
+		return fmt.Errorf("%v didn't miss any points", tokens[1])
+	}
+	return parser.apply(Event{Type: EventMissed, Player: index, Scores: missed.Scores, Total: points})
+}
+
+// parseMuggins gives missed points to an opponent who called muggins
+func (parser *notationParser) parseMuggins(tokens []string, points int, claimed bool) (err error) {
+	game := &parser.game
+	if len(tokens) != 2 || !parser.muggins || !claimed || game.Missed == nil {
+		return fmt.Errorf("unexpected muggins %v", tokens)
+	}
+	index, err := parser.player(tokens[1])
+	if err != nil {
+		return
+	}
+	if game.Team(index) == game.Team(game.Missed.Player) {
+		return fmt.Errorf("%v can't take their own team's missed points", tokens[1])
+	}
+	if points != game.Missed.Points {
+		return fmt.Errorf("muggins for %v points, %v were missed", points, game.Missed.Points)
+	}
+	score := mugginsScore
+	score.Value = points
+	return parser.apply(Event{Type: EventMuggins, Player: index, Scores: []Score{score}})
+}
+
+// parseWinner records the claimed winner, which is checked at the end of the game
+func (parser *notationParser) parseWinner(tokens []string) (err error) {
+	if len(tokens) != 2 {
+		return fmt.Errorf("invalid winner %v", tokens)
+	}
+	_, err = parser.player(tokens[1])
+	parser.winner = tokens[1]
+	return
+}
+
+// finish checks the game is complete enough to replay
+func (parser *notationParser) finish() error {
+	if !parser.started {
+		return parser.start()
+	}
+	if parser.deal != nil {
+		return fmt.Errorf("round %v has %v of %v hands", parser.deal.Round, parser.dealtHands(), len(parser.game.Players))
+	}
+	if parser.threeOwed {
+		return fmt.Errorf("missing the three for last")
+	}
+	if parser.winner == "" {
+		return nil
+	}
+	if parser.game.Winner == nil || parser.game.Winner.Name != parser.winner {
+		return fmt.Errorf("%v is named the winner, but hasn't won", parser.winner)
+	}
+	return nil
+}
+
+// apply applies an event to the parser's game and adds it to the log
+func (parser *notationParser) apply(event Event) (err error) {
+	if event.Type != EventNewGame && event.Type != EventDeal {
+		event.Round = parser.game.Round
+	}
+	err = parser.game.applyEvent(event)
+	if err != nil {
+		return
+	}
+	parser.events = append(parser.events, event)
+	return
+}
+
+// player returns the index of a named player
+func (parser *notationParser) player(name string) (int, error) {
+	index, found := parser.playerIndex(name)
+	if !found {
+		return -1, fmt.Errorf("unknown player %v", name)
+	}
+	return index, nil
+}
+
+// playerIndex finds a player by name
+func (parser *notationParser) playerIndex(name string) (int, bool) {
+	for index, player := range parser.game.Players {
+		if player.Name == name {
+			return index, true
+		}
+	}
+	return -1, false
+}
+
+// notationTokens splits a line into words and quoted names, dropping comments
+func notationTokens(line string) (tokens []string, err error) {
+	tokens = []string{}
+	for {
+		line = strings.TrimLeft(line, " \t")
+		if line == "" || line[0] == ';' {
+			return
+		}
+		if line[0] != '"' {
+			end := strings.IndexAny(line, " \t;")
+			if end < 0 {
+				end = len(line)
+			}
+			tokens = append(tokens, line[:end])
+			line = line[end:]
+			continue
+		}
+
+		end := 1
+		for end < len(line) && line[end] != '"' {
+			if line[end] == '\\' {
+				end++
+			}
+			end++
+		}
+		name, unquoteErr := strconv.Unquote(line[:notationMin(end+1, len(line))])
+		if unquoteErr != nil {
+			return nil, fmt.Errorf("invalid quoted name %v", line)
+		}
+		line = line[end+1:]
+		// A closing bracket can follow a name in a tag
+		if strings.HasPrefix(line, "]") {
+			name, line = name+"]", line[1:]
+		}
+		tokens = append(tokens, name)
+	}
+}
+
+// notationClaim splits a trailing "for N" claim from a line
+func notationClaim(tokens []string) (rest []string, points int, claimed bool, err error) {
+	size := len(tokens)
+	if size < 2 || tokens[size-2] != "for" {
+		return tokens, 0, false, nil
+	}
+	points, err = strconv.Atoi(tokens[size-1])
+	if err != nil || points < 0 {
+		return nil, 0, false, fmt.Errorf("invalid points %v", tokens[size-1])
+	}
+	return tokens[:size-2], points, true, nil
+}
+
+// claim returns the scores for a player's claim, keeping what it missed for
+// a following Missed line. Under muggins a player can claim less than the
+// rules give
+func (parser *notationParser) claim(index int, points int, claimed bool, scores []Score) ([]Score, error) {
+	if parser.muggins && claimed && points < scoresTotal(scores) {
+		parser.claimed = &MissedScore{Player: index, Points: scoresTotal(scores) - points, Scores: scores}
+		return claimedScores(points), nil
+	}
+	parser.claimed = &MissedScore{Player: index, Scores: scores}
+	return scores, checkNotationClaim(points, claimed, scores)
+}
+
+// checkNotationClaim compares claimed points with the scores the rules give
+func checkNotationClaim(points int, claimed bool, scores []Score) error {
+	if !claimed {
+		return fmt.Errorf("missing points, want for %v", scoresTotal(scores))
+	}
+	if points != scoresTotal(scores) {
+		return fmt.Errorf("claimed %v points, the rules give %v %v", points, scoresTotal(scores), scores)
+	}
+	return nil
+}
+
+// checkNotationTotal compares a running total with the field's
+func checkNotationTotal(token string, total int) error {
+	claimed, err := strconv.Atoi(token)
+	if err != nil || claimed != total {
+		return fmt.Errorf("got running total %v, want %v", token, total)
+	}
+	return nil
+}
+
+// notationRules are the tags of the rules a variant can change
+var notationRules = []struct {
+	tag   string
+	field func(rules *Rules) interface{}
+}{
+	{"HandSize", func(rules *Rules) interface{} { return &rules.HandSize }},
+	{"CribSize", func(rules *Rules) interface{} { return &rules.CribSize }},
+	{"PegLimit", func(rules *Rules) interface{} { return &rules.PegLimit }},
+	{"SingleCount", func(rules *Rules) interface{} { return &rules.SingleCount }},
+	{"PoneStart", func(rules *Rules) interface{} { return &rules.PoneStart }},
+	{"CribFlushStarter", func(rules *Rules) interface{} { return &rules.CribFlushStarter }},
+	{"HandFlushStarter", func(rules *Rules) interface{} { return &rules.HandFlushStarter }},
+	{"Nobs", func(rules *Rules) interface{} { return &rules.Nobs }},
+	{"HisHeels", func(rules *Rules) interface{} { return &rules.HisHeels }},
+	{"Nineteen", func(rules *Rules) interface{} { return &rules.Nineteen }},
+	{"Lowball", func(rules *Rules) interface{} { return &rules.Lowball }},
+}
+
+// writeNotationRules writes the Variant tag and the rules changed from its
+// preset, for games not played by six-card rules
+func writeNotationRules(builder *strings.Builder, rules *Rules) error {
+	if rules == nil || *rules == SixCardRules {
+		return nil
+	}
+	preset, found := PresetRules(rules.Name)
+	if !found {
+		return fmt.Errorf("rules %q aren't named after a preset variant", rules.Name)
+	}
+	fmt.Fprintf(builder, "[Variant %q]\n", rules.Name)
+	for _, rule := range notationRules {
+		value := notationRuleValue(rule.field(rules))
+		if value != notationRuleValue(rule.field(&preset)) {
+			fmt.Fprintf(builder, "[%v %q]\n", rule.tag, value)
+		}
+	}
+	return nil
+}
+
+// notationRuleValue formats the value of a rule
+func notationRuleValue(field interface{}) string {
+	switch value := field.(type) {
+	case *int:
+		return strconv.Itoa(*value)
+	case *bool:
+		return strconv.FormatBool(*value)
+	}
+	return ""
+}
+
+// parseNotationCards parses a list of card codes
+func parseNotationCards(tokens []string) (cards Hand, err error) {
+	cards = Hand{}
+	for _, token := range tokens {
+		var card Card
+		card, err = ParseCard(token)
+		if err != nil {
+			return
+		}
+		cards = append(cards, card)
+	}
+	return
+}
+
+// notationCards writes a hand as card codes
+func notationCards(hand Hand) string {
+	codes := []string{}
+	for _, card := range hand {
+		codes = append(codes, card.Code())
+	}
+	return strings.Join(codes, " ")
+}
+
+// notationName quotes a name that wouldn't read back as a single word
+func notationName(name string) string {
+	if strings.ContainsAny(name, " \t;\"") || strings.HasPrefix(name, "[") {
+		return strconv.Quote(name)
+	}
+	return name
+}
+
+// notationHas returns whether a hand holds every card in a list
+func notationHas(hand Hand, cards Hand) bool {
+	hand = copyHand(hand)
+	for _, card := range cards {
+		if !hand.Contains(card) {
+			return false
+		}
+		hand = hand.RemoveCard(card)
+	}
+	return true
+}
+
+// notationContains returns whether a name is in a list
+func notationContains(names []string, name string) bool {
+	for _, listed := range names {
+		if listed == name {
+			return true
+		}
+	}
+	return false
+}
+
+// notationMin returns the smaller of two ints
+func notationMin(a, b int) int {
+	if a < b {
+		return a
+	}
+	return b
+}
+
+// scoresTotal adds up a list of scores
+func scoresTotal(scores []Score) (total int) {
+	for _, score := range scores {
+		total += score.Value
+	}
+	return
+}
@@ -0,0 +1,196 @@
+package poner_test
+
+import (
+	"bytes"
+	"io/ioutil"
+	"strings"
+	"testing"
+
+	"github.com/blakecallens/poner"
+)
+
+// playGame plays a seeded game between computer players to the end
+func playGame(t *testing.T, seed int64, names ...string) *poner.Game {
+	players := []poner.Player{}
+	for _, name := range names {
+		players = append(players, poner.Player{Name: name, IsComputer: true, SkillLevel: 4})
+	}
+	game := &poner.Game{}
+	game.SetSeed(seed)
+	game.New(players)
+	playUntilOver(t, game)
+	return game
+}
+
+func TestNotationRoundTrip(t *testing.T) {
+	for seed, names := range [][]string{{"Bob", "Sue"}, {"Bob", "Sue Ellen", "Dan"}, {"Bob", "Sue", "Dan", "Joe"}} {
+		game := playGame(t, int64(seed), names...)
+		written := bytes.Buffer{}
+		err := game.WriteNotation(&written)
+		if err != nil {
+			t.Errorf("Error writing notation: %v", err)
+			return
+		}
+		events, err := poner.ParseNotation(strings.NewReader(written.String()))
+		if err != nil {
+			t.Errorf("Error parsing notation: %v\n%v", err, written.String())
+			return
+		}
+		replayed, err := poner.Replay(events, len(events))
+		if err != nil {
+			t.Errorf("Error replaying notation: %v", err)
+			return
+		}
+		for ii, player := range replayed.Players {
+			if player.Score != game.Players[ii].Score {
+				t.Errorf("Error replaying notation, got score %v, want %v", player.Score, game.Players[ii].Score)
+			}
+		}
+		if replayed.Winner == nil || replayed.Winner.Name != game.Winner.Name {
+			t.Errorf("Error replaying notation, got winner %v, want %v", replayed.Winner, game.Winner.Name)
+		}
+
+		rewritten := bytes.Buffer{}
+		err = replayed.WriteNotation(&rewritten)
+		if err != nil || rewritten.String() != written.String() {
+			t.Errorf("Error rewriting notation, got %v\n%v", err, rewritten.String())
+		}
+	}
+}
+
+func TestParseNotation(t *testing.T) {
+	notation := `[Event "Club night"]
+[Players "Bob" "Sue"]
+[ToWin "121"]
+
+Round 1 dealer Sue
+Hand Bob 5H 6H 7C 8D JS KH
+Hand Sue AC 2D 3S 4H 9C QD
+Discard Bob JS KH ; keeping the run
+Discard Sue 9C QD
+Starter 5D
+Play Bob 5H 5
+Play Sue 3S 8
+Play Bob 7C 15 for 2 ; fifteen two
+Play Sue AC 16
+Play Bob 6H 22
+Play Sue 2D 24
+Play Bob 8D 32
+`
+	_, err := poner.ParseNotation(strings.NewReader(notation))
+	if err == nil || !strings.Contains(err.Error(), "line 17") {
+		t.Errorf("Error parsing notation, got %v, want an error for the count past 31", err)
+	}
+
+	notation = strings.Replace(notation, "Play Bob 8D 32\n", `Go Bob 24
+Play Sue 4H 28
+Last Sue for 1
+Reset
+Play Bob 8D 8
+Last Bob for 1
+Reset
+Show Bob 5H 6H 7C 8D for 12
+Show Sue AC 2D 3S 4H for 7
+Crib Sue JS KH 9C QD for 9
+`, 1)
+	events, err := poner.ParseNotation(strings.NewReader(notation))
+	if err != nil {
+		t.Errorf("Error parsing notation: %v", err)
+		return
+	}
+	game, err := poner.Replay(events, len(events))
+	if err != nil {
+		t.Errorf("Error replaying notation: %v", err)
+		return
+	}
+	if game.Players[0].Score != 15 || game.Players[1].Score != 17 {
+		t.Errorf("Error replaying notation, got scores %v, want [15 17]", game.Scores())
+	}
+
+	// Over claiming is caught
+	_, err = poner.ParseNotation(strings.NewReader(strings.Replace(notation, "for 12", "for 14", 1)))
+	if err == nil || !strings.Contains(err.Error(), "claimed 14 points") {
+		t.Errorf("Error parsing notation, got %v, want an error for the claim", err)
+	}
+
+	// Pegging out of turn and misattributed or repeated last cards are caught
+	for _, test := range []struct{ old, new, want string }{
+		{"Play Sue 3S 8\n", "", "out of turn"},
+		{"Go Bob 24", "Go Sue 24", "out of turn"},
+		{"Last Sue for 1", "Last Bob for 1", "didn't play the last card"},
+		{"Last Sue for 1\n", "Last Sue for 1\nLast Sue for 1\n", "unexpected last card"},
+		{"[Players", "[Variant \"Nine-Card\"]\n[Players", "unknown variant"},
+	} {
+		_, err = poner.ParseNotation(strings.NewReader(strings.Replace(notation, test.old, test.new, 1)))
+		if err == nil || !strings.Contains(err.Error(), test.want) {
+			t.Errorf("Error parsing notation with %q, got %v, want %v", test.new, err, test.want)
+		}
+	}
+
+	// Muggins has to take the points the last claim missed, for an opponent
+	notation = strings.Replace(notation, "[Players", "[Muggins \"true\"]\n[Players", 1)
+	notation = strings.Replace(notation, "Show Bob 5H 6H 7C 8D for 12\n",
+		"Show Bob 5H 6H 7C 8D for 10\nMissed Bob for 2\nMuggins Sue for 2\n", 1)
+	events, err = poner.ParseNotation(strings.NewReader(notation))
+	if err != nil {
+		t.Errorf("Error parsing muggins notation: %v", err)
+		return
+	}
+	game, err = poner.Replay(events, len(events))
+	if err != nil || game.Players[0].Score != 13 || game.Players[1].Score != 19 {
+		t.Errorf("Error replaying muggins notation, got scores %v, want [13 19], %v", game.Scores(), err)
+	}
+	for _, test := range []struct{ old, new, want string }{
+		{"Starter 5D\n", "Starter 5D\nMuggins Bob for 120\n", "unexpected muggins"},
+		{"Missed Bob for 2\n", "", "unexpected muggins"},
+		{"Muggins Sue for 2", "Muggins Bob for 2", "own team"},
+		{"Muggins Sue for 2", "Muggins Sue for 3", "2 were missed"},
+		{"Missed Bob for 2", "Missed Bob for 3", "missed 2 points"},
+		{"Missed Bob for 2", "Missed Sue for 2", "didn't make the last claim"},
+	} {
+		_, err = poner.ParseNotation(strings.NewReader(strings.Replace(notation, test.old, test.new, 1)))
+		if err == nil || !strings.Contains(err.Error(), test.want) {
+			t.Errorf("Error parsing notation with %q, got %v, want %v", test.new, err, test.want)
+		}
+	}
+}
+
+func TestNotationDocExample(t *testing.T) {
+	source, err := ioutil.ReadFile("notation.go")
+	if err != nil {
+		t.Fatal(err)
+	}
+	lines := []string{}
+	for _, line := range strings.Split(string(source), "\n") {
+		if strings.HasPrefix(line, "//\t") {
+			lines = append(lines, strings.TrimPrefix(line, "//\t"))
+		} else if line == "//" && len(lines) > 0 {
+			lines = append(lines, "")
+		}
+	}
+	if len(lines) == 0 {
+		t.Fatal("Error finding the notation example in notation.go")
+	}
+	events, err := poner.ParseNotation(strings.NewReader(strings.Join(lines, "\n")))
+	if err != nil {
+		t.Fatalf("Error parsing the notation example, got %v", err)
+	}
+	game, err := poner.Replay(events, len(events))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if game.Players[0].Score != 15 || game.Players[1].Score != 17 {
+		t.Errorf("Error parsing the notation example, got scores %v and %v, want 15 and 17", game.Players[0].Score, game.Players[1].Score)
+	}
+}
+
+func TestWriteNotationRules(t *testing.T) {
+	rules := poner.SixCardRules
+	rules.Name = "House"
+	game := poner.Game{Rules: rules}
+	game.New([]poner.Player{{Name: "Bob"}, {Name: "Sue"}})
+	err := game.WriteNotation(&bytes.Buffer{})
+	if err == nil {
+		t.Error("Error writing notation, did not get err for rules that aren't a preset")
+	}
+}
@@ -0,0 +1,346 @@
+package poner
+
+import (
+	"errors"
+	"fmt"
+)
+
+// Phase is the stage of a round a game is in
+type Phase int
+
+// The phases of a game
+const (
+	PhaseDeal Phase = iota
+	PhaseDiscard
+	PhaseCut
+	PhasePegging
+	PhaseShow
+	PhaseCrib
+	PhaseGameOver
+)
+
+var phaseNames = [...]string{"Deal", "Discard", "Cut", "Pegging", "Show", "Crib", "Game Over"}
+
+func (phase Phase) String() string {
+	if phase < 0 || int(phase) >= len(phaseNames) {
+		return fmt.Sprintf("Phase(%d)", int(phase))
+	}
+	return phaseNames[phase]
+}
+
+// ActionType is the kind of action a player takes
+type ActionType int
+
+// The different types of actions
+const (
+	ActionDeal ActionType = iota
+	ActionDiscard
+	ActionPlay
+	ActionGo
+	ActionShow
+	ActionCrib
+	ActionMuggins
+)
+
+var actionNames = [...]string{"Deal", "Discard", "Play", "Go", "Show", "Crib", "Muggins"}
+
+func (actionType ActionType) String() string {
+	if actionType < 0 || int(actionType) >= len(actionNames) {
+		return fmt.Sprintf("Action(%d)", int(actionType))
+	}
+	return actionNames[actionType]
+}
+
+// actionPhases are the phases each action is allowed in. Muggins can be
+// called in any phase while there are missed points
+var actionPhases = [...]Phase{PhaseDeal, PhaseDiscard, PhasePegging, PhasePegging, PhaseShow, PhaseCrib}
+
+// Action is a single move by a player. Under muggins, Points is what a human
+// player claims for a play, show or crib. Points for the go, the last card,
+// his heels and three for last are scored in full, since no action claims them
+type Action struct {
+	Type   ActionType
+	Player int
+	Card   Card
+	Cards  Hand
+	Points int
+}
+
+func (action Action) String() string {
+	switch action.Type {
+	case ActionDiscard:
+		return fmt.Sprintf("%v: player %v %v", action.Type, action.Player, action.Cards)
+	case ActionPlay:
+		return fmt.Sprintf("%v: player %v %v", action.Type, action.Player, action.Card)
+	default:
+		return fmt.Sprintf("%v: player %v", action.Type, action.Player)
+	}
+}
+
+// PhaseError is returned when an action is applied outside of its phase
+type PhaseError struct {
+	Action ActionType
+	Phase  Phase
+}
+
+func (err PhaseError) Error() string {
+	return fmt.Sprintf("Apply:: %v is not allowed during %v", err.Action, err.Phase)
+}
+
+// TurnError is returned when a player acts out of turn
+type TurnError struct {
+	Action   ActionType
+	Player   int
+	Expected int
+}
+
+func (err TurnError) Error() string {
+	return fmt.Sprintf("Apply:: player %v cannot %v, it is player %v's turn", err.Player, err.Action, err.Expected)
+}
+
+// PlayerToAct returns the index of the player who must act next, or -1 if
+// any player may act (discarding) or the game is over
+func (game *Game) PlayerToAct() int {
+	switch game.Phase {
+	case PhaseDeal:
+		return game.nextIndex(game.Dealer)
+	case PhasePegging, PhaseShow, PhaseCrib:
+		return game.ActivePlayer
+	default:
+		return -1
+	}
+}
+
+// Apply validates an action against the game's phase and turn and applies it
+func (game *Game) Apply(action Action) (scores []Score, err error) {
+	if action.Type < 0 || int(action.Type) >= len(actionNames) {
+		err = fmt.Errorf("Apply:: unknown action %v", action.Type)
+		return
+	}
+	if action.Player < 0 || action.Player >= len(game.Players) {
+		err = fmt.Errorf("Apply:: invalid player %v", action.Player)
+		return
+	}
+	if game.Phase == PhaseGameOver {
+		err = ErrGameOver
+		return
+	}
+	if action.Type == ActionMuggins {
+		scores, err = game.applyMuggins(action)
+		return
+	}
+	if game.Phase != actionPhases[action.Type] {
+		err = PhaseError{Action: action.Type, Phase: game.Phase}
+		return
+	}
+	expected := game.PlayerToAct()
+	if expected >= 0 && action.Player != expected {
+		err = TurnError{Action: action.Type, Player: action.Player, Expected: expected}
+		return
+	}
+
+	// Missed points can only be taken before the next action
+	missed := game.Missed
+	game.Missed = nil
+	switch action.Type {
+	case ActionDeal:
+		scores, err = game.applyDeal()
+	case ActionDiscard:
+		scores, err = game.applyDiscard(action)
+	case ActionPlay:
+		scores, err = game.applyPlay(action)
+	case ActionGo:
+		err = game.applyGo(action)
+	case ActionShow:
+		scores, _ = game.showHand(action.Player, &action)
+	case ActionCrib:
+		scores, _ = game.showCrib(&action)
+	}
+	if err != nil {
+		game.Missed = missed
+	} else if game.Missed != nil {
+		game.record(Event{Type: EventMissed, Player: game.Missed.Player, Scores: game.Missed.Scores,
+			Total: game.Missed.Points})
+	}
+	return
+}
+
+// ComputerAction returns the action the computer player to act would take
+func (game *Game) ComputerAction() (action Action, err error) {
+	caller := game.mugginsCaller()
+	if caller >= 0 {
+		action = Action{Type: ActionMuggins, Player: caller}
+		return
+	}
+	index := game.PlayerToAct()
+	if index < 0 || !game.Players[index].IsComputer {
+		err = errors.New("ComputerAction:: no computer player to act")
+		return
+	}
+
+	action.Player = index
+	switch game.Phase {
+	case PhaseDeal:
+		action.Type = ActionDeal
+	case PhasePegging:
+		card, cantPlay, playErr := game.computerPlay(index)
+		if playErr != nil {
+			err = playErr
+			return
+		}
+		action.Type, action.Card = ActionPlay, card
+		if cantPlay {
+			action.Type = ActionGo
+		}
+	case PhaseShow:
+		action.Type = ActionShow
+	case PhaseCrib:
+		action.Type = ActionCrib
+	}
+	return
+}
+
+// applyDeal deals a new round, moving straight to the cut if every player has discarded
+func (game *Game) applyDeal() (scores []Score, err error) {
+	err = game.deal()
+	if err != nil || !game.AllPlayersDiscarded() {
+		return
+	}
+	score, err := game.cut()
+	if err != nil {
+		return
+	}
+	scores = game.startPegging(score)
+	return
+}
+
+// applyDiscard sets a human player's discard from their dealt hand
+func (game *Game) applyDiscard(action Action) (scores []Score, err error) {
+	score, err := game.HumanDiscard(action.Player, action.Cards)
+	if err != nil || game.Phase == PhaseDiscard {
+		return
+	}
+	scores = game.startPegging(score)
+	return
+}
+
+// startPegging gives the lead to the player left of the dealer once the starter is turned
+func (game *Game) startPegging(score Score) (scores []Score) {
+	if score.Value > 0 {
+		scores = []Score{score}
+	}
+	game.ActivePlayer = game.nextIndex(game.Dealer)
+	game.LastPlayer = game.Dealer
+	return
+}
+
+// applyPlay puts the active player's card into the field
+func (game *Game) applyPlay(action Action) (scores []Score, err error) {
+	player := &game.Players[action.Player]
+	if !player.PlayingHand.Contains(action.Card) {
+		err = fmt.Errorf("Apply:: %v is not in player %v's hand", action.Card, action.Player)
+		return
+	}
+	if !game.rules().CanBePlayed(action.Card, game.Field) {
+		err = fmt.Errorf("Apply:: %v cannot be played", action.Card)
+		return
+	}
+	scores, err = game.playCard(action.Card, player, &action)
+	if err != nil || game.Winner != nil {
+		return
+	}
+	if game.Field.GetTotal() == game.rules().PegLimit {
+		game.endSequence()
+	}
+	game.advancePegging()
+	return
+}
+
+// applyGo marks the active player as gone when they have no playable card
+func (game *Game) applyGo(action Action) (err error) {
+	player := &game.Players[action.Player]
+	if game.rules().CanPlay(player.PlayingHand, game.Field) {
+		err = errors.New("Apply:: invalid go attempt. Card(s) can be played")
+		return
+	}
+	player.Gone = true
+	game.record(Event{Type: EventGo, Player: action.Player, Total: game.Field.GetTotal()})
+	game.advancePegging()
+	return
+}
+
+// advancePegging passes the turn to the next player able to act, scoring
+// the go and resetting the field when nobody can continue the count
+func (game *Game) advancePegging() {
+	for game.Winner == nil {
+		if game.AllPlaysDone() {
+			if len(game.Field) > 0 {
+				game.endSequence()
+			}
+			if game.Winner == nil {
+				game.Phase = PhaseShow
+				game.ActivePlayer = game.nextIndex(game.Dealer)
+			}
+			return
+		}
+		if game.passTurn() {
+			return
+		}
+		game.endSequence()
+	}
+}
+
+// passTurn gives the turn to the next player who hasn't gone and still has cards
+func (game *Game) passTurn() bool {
+	for step := 1; step <= len(game.Players); step++ {
+		index := (game.ActivePlayer + step) % len(game.Players)
+		player := &game.Players[index]
+		if !player.Gone && len(player.PlayingHand) > 0 {
+			game.ActivePlayer = index
+			return true
+		}
+	}
+	return false
+}
+
+// endSequence gives the last player to play the go and resets the field
+func (game *Game) endSequence() {
+	game.ActivePlayer = game.LastPlayer
+	game.GoScore()
+	if game.Winner == nil {
+		game.ResetField()
+	}
+}
+
+// showHand scores a player's hand and moves the show along
+func (game *Game) showHand(index int, action *Action) (scores []Score, total int) {
+	scores, total = game.scoreHand(&game.Players[index], false, action)
+	if game.Winner != nil {
+		return
+	}
+	game.ActivePlayer = game.nextIndex(index)
+	if index == game.Dealer {
+		game.Phase = PhaseCrib
+		game.ActivePlayer = game.Dealer
+	}
+	return
+}
+
+// showCrib scores the dealer's crib and ends the round
+func (game *Game) showCrib(action *Action) (scores []Score, total int) {
+	scores, total = game.scoreHand(&game.Players[game.Dealer], true, action)
+	if game.Winner == nil {
+		game.Phase = PhaseDeal
+	}
+	return
+}
+
+// AllPlayersDiscarded returns whether all players have set their discard
+func (game *Game) AllPlayersDiscarded() bool {
+	for _, player := range game.Players {
+		if len(player.Discard.Held) == 0 {
+			return false
+		}
+	}
+	return true
+}
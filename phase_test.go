@@ -0,0 +1,195 @@
+package poner_test
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/blakecallens/poner"
+)
+
+func TestPhaseString(t *testing.T) {
+	if poner.PhaseGameOver.String() != "Game Over" {
+		t.Errorf("Error stringing phase, got %v, want Game Over", poner.PhaseGameOver)
+	}
+	if poner.ActionDiscard.String() != "Discard" {
+		t.Errorf("Error stringing action type, got %v, want Discard", poner.ActionDiscard)
+	}
+}
+
+func TestApplyGame(t *testing.T) {
+	players := []poner.Player{
+		{Name: "Bob", IsComputer: true, SkillLevel: 4},
+		{Name: "Sue", IsComputer: false, SkillLevel: 4},
+	}
+	game := poner.Game{}
+	game.New(players)
+
+	for actions := 0; game.Phase != poner.PhaseGameOver; actions++ {
+		if actions > 10000 {
+			t.Error("Error applying actions, game did not finish")
+			return
+		}
+		action, err := humanAction(&game, 1)
+		if err != nil {
+			action, err = game.ComputerAction()
+		}
+		if err != nil {
+			t.Errorf("Error getting action in %v: %v", game.Phase, err)
+			return
+		}
+		_, err = game.Apply(action)
+		if err != nil {
+			t.Errorf("Error applying %v: %v", action, err)
+			return
+		}
+	}
+	if game.Winner == nil {
+		t.Error("Error applying actions, game over without a winner")
+	}
+
+	replayed, err := poner.Replay(game.Events, len(game.Events))
+	if err != nil {
+		t.Errorf("Error replaying game: %v", err)
+		return
+	}
+	for ii, player := range replayed.Players {
+		if player.Score != game.Players[ii].Score {
+			t.Errorf("Error replaying game, got score %v, want %v", player.Score, game.Players[ii].Score)
+		}
+	}
+}
+
+func TestApplyErrors(t *testing.T) {
+	players := []poner.Player{
+		{Name: "Bob", IsComputer: true, SkillLevel: 4},
+		{Name: "Sue", IsComputer: false, SkillLevel: 4},
+	}
+	game := poner.Game{}
+	game.New(players)
+
+	_, err := game.Apply(poner.Action{Type: poner.ActionShow, Player: 0})
+	var phaseErr poner.PhaseError
+	if !errors.As(err, &phaseErr) || phaseErr.Phase != poner.PhaseDeal {
+		t.Errorf("Error applying out of phase action, got %v, want PhaseError", err)
+	}
+
+	dealer := game.PlayerToAct()
+	_, err = game.Apply(poner.Action{Type: poner.ActionDeal, Player: 1 - dealer})
+	var turnErr poner.TurnError
+	if !errors.As(err, &turnErr) || turnErr.Expected != dealer {
+		t.Errorf("Error applying out of turn action, got %v, want TurnError", err)
+	}
+
+	_, err = game.Apply(poner.Action{Type: poner.ActionDeal, Player: dealer})
+	if err != nil {
+		t.Errorf("Error applying deal: %v", err)
+		return
+	}
+	if game.Phase != poner.PhaseDiscard {
+		t.Errorf("Error applying deal, got phase %v, want Discard", game.Phase)
+	}
+	_, err = game.Apply(poner.Action{Type: poner.ActionPlay, Player: 1, Card: game.Players[1].DealtHand[0]})
+	if !errors.As(err, &phaseErr) {
+		t.Errorf("Error applying play before discarding, got %v, want PhaseError", err)
+	}
+	_, err = game.Apply(poner.Action{Type: poner.ActionDiscard, Player: 1, Cards: game.Players[0].DealtHand[:2]})
+	if err == nil {
+		t.Error("Error applying discard, did not get err for cards not dealt to player")
+	}
+	_, err = game.Apply(poner.Action{Type: poner.ActionDiscard, Player: 1, Cards: game.Players[1].DealtHand[:2]})
+	if err != nil {
+		t.Errorf("Error applying discard: %v", err)
+		return
+	}
+	if game.Phase != poner.PhasePegging && game.Phase != poner.PhaseGameOver {
+		t.Errorf("Error applying discard, got phase %v, want Pegging", game.Phase)
+	}
+}
+
+// humanAction picks an action for a human player using the computer's best choices
+func humanAction(game *poner.Game, index int) (action poner.Action, err error) {
+	player := &game.Players[index]
+	action.Player = index
+	switch {
+	case game.Phase == poner.PhaseDiscard && len(player.Discard.Held) == 0:
+		discard := player.DealtHand.GetHolds(game.HandSize(), &game.Deck, game.Dealer == index)[0]
+		action.Type, action.Cards = poner.ActionDiscard, discard.Discarded
+	case game.PlayerToAct() != index:
+		err = errors.New("not the human's turn")
+	case game.Phase == poner.PhasePegging:
+		nextPlayer := game.Players[(index+1)%len(game.Players)]
+		card, cantPlay := player.PlayingHand.GetBestPlay(game.Field, nextPlayer.Public())
+		action.Type, action.Card = poner.ActionPlay, card
+		if cantPlay {
+			action.Type = poner.ActionGo
+		}
+	case game.Phase == poner.PhaseShow:
+		action.Type = poner.ActionShow
+	case game.Phase == poner.PhaseCrib:
+		action.Type = poner.ActionCrib
+	case game.Phase == poner.PhaseDeal:
+		action.Type = poner.ActionDeal
+	default:
+		err = errors.New("no human action")
+	}
+	return
+}
+
+func TestThreePlayerGo(t *testing.T) {
+	players := []poner.Player{
+		{Name: "Bob", IsComputer: false, SkillLevel: 4},
+		{Name: "Sue", IsComputer: false, SkillLevel: 4},
+		{Name: "Dan", IsComputer: false, SkillLevel: 4},
+	}
+	game := poner.Game{}
+	game.New(players)
+	deck := poner.Deck{}.New()
+	for index, cards := range []string{"Kh Qh", "9c 2c", "Ad 3s"} {
+		hand, err := deck.PullCards(cards)
+		if err != nil {
+			t.Errorf("Error pulling cards from deck: %v", err)
+			return
+		}
+		game.Players[index].PlayingHand = hand
+	}
+	game.Phase, game.Dealer, game.ActivePlayer = poner.PhasePegging, 2, 0
+
+	// Bob reaches 30 with his last card, both opponents go and Bob takes the go
+	for _, action := range []poner.Action{
+		{Type: poner.ActionPlay, Player: 0, Card: game.Players[0].PlayingHand[0]},
+		{Type: poner.ActionPlay, Player: 1, Card: game.Players[1].PlayingHand[0]},
+		{Type: poner.ActionPlay, Player: 2, Card: game.Players[2].PlayingHand[0]},
+		{Type: poner.ActionPlay, Player: 0, Card: game.Players[0].PlayingHand[1]},
+		{Type: poner.ActionGo, Player: 1},
+		{Type: poner.ActionGo, Player: 2},
+	} {
+		_, err := game.Apply(action)
+		if err != nil {
+			t.Errorf("Error applying %v: %v", action, err)
+			return
+		}
+	}
+	if game.Players[0].Score != 1 || len(game.Field) != 0 {
+		t.Errorf("Error scoring the go, got score %v and field %v, want 1 and a reset field",
+			game.Players[0].Score, game.Field)
+	}
+	// Bob is out of cards, so Sue leads the next count
+	if game.PlayerToAct() != 1 {
+		t.Errorf("Error passing the lead, got player %v, want 1", game.PlayerToAct())
+	}
+
+	// Dan, the last to play, takes the go when the cards run out
+	for _, action := range []poner.Action{
+		{Type: poner.ActionPlay, Player: 1, Card: game.Players[1].PlayingHand[0]},
+		{Type: poner.ActionPlay, Player: 2, Card: game.Players[2].PlayingHand[0]},
+	} {
+		_, err := game.Apply(action)
+		if err != nil {
+			t.Errorf("Error applying %v: %v", action, err)
+			return
+		}
+	}
+	if game.Players[2].Score != 1 || game.Phase != poner.PhaseShow {
+		t.Errorf("Error ending the pegging, got score %v in %v, want 1 in Show", game.Players[2].Score, game.Phase)
+	}
+}
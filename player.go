@@ -1,10 +1,9 @@
 package poner
 
 import (
-	"math"
+	"fmt"
 	"math/rand"
 	"sort"
-	"time"
 )
 
 // Player holds the data for a player in the game
@@ -19,6 +18,8 @@ type Player struct {
 	Gone        bool
 	IsComputer  bool
 	SkillLevel  int
+	Random      *rand.Rand
+	Strategy    Strategy
 }
 
 // AddScore adds scores to the player's total
@@ -35,17 +36,52 @@ func (player *Player) AddScore(scores []Score) (total int) {
 	return
 }
 
-// TakeDeal gives dealt cards to a player
-func (player *Player) TakeDeal(hand Hand, deck *Deck, isDealer bool) {
-	player.DealtHand = hand
-	if player.IsComputer {
-		discards := hand.GetDiscards(deck, isDealer)
-		skillAdjust := player.GetSkillAdjust(len(discards))
-		player.SetDiscard(discards[skillAdjust])
-	} else {
-		player.Discard = Discard{}
-		player.PlayingHand = Hand{}
+// TakeDeal gives dealt cards to a player outside of a game. The player is
+// seated at a two player table as player 0, with the opponent in seat 1.
+// Computer players only see their own hand, so the deck isn't shown to them
+func (player *Player) TakeDeal(hand Hand, deck *Deck, isDealer bool) error {
+	seat := PublicPlayer{Name: player.Name, Score: player.Score}
+	view := PlayerView{
+		Hand:    hand,
+		Dealt:   hand,
+		Dealer:  1,
+		Players: []PublicPlayer{seat, {}},
+		Scores:  []int{player.Score, 0},
+		ToWin:   SixCardRules.ToWin,
+		Rules:   SixCardRules,
 	}
+	if isDealer {
+		view.Dealer = view.Index
+	}
+	return player.TakeDealView(view)
+}
+
+// TakeDealView gives the dealt cards of a view to a player, with computer
+// players choosing their discard from what the view lets them see
+func (player *Player) TakeDealView(view PlayerView) (err error) {
+	player.DealtHand = view.Hand
+	player.Discard = Discard{}
+	player.PlayingHand = Hand{}
+	if !player.IsComputer {
+		return
+	}
+
+	view.SkillLevel, view.Random = player.SkillLevel, player.random()
+	discard := player.GetStrategy().ChooseDiscard(view)
+	if !discard.isFrom(view.Hand, view.rules().HandSize) {
+		err = fmt.Errorf("TakeDeal:: invalid discard %v for %v", discard, view.Hand)
+		return
+	}
+	player.SetDiscard(discard)
+	return
+}
+
+// GetStrategy returns the player's strategy, or the default strategy if none is set
+func (player *Player) GetStrategy() Strategy {
+	if player.Strategy == nil {
+		return DefaultStrategy{}
+	}
+	return player.Strategy
 }
 
 // SetDiscard set's the player's discard and playing hand
@@ -62,9 +98,13 @@ func (player *Player) SetDiscard(discard Discard) {
 
 // GetSkillAdjust gets a random skill ajustment for player skill
 func (player *Player) GetSkillAdjust(maxAdjust int) int {
-	rand.Seed(time.Now().UnixNano())
-	maxSkilllevel := math.Min(4, float64(player.SkillLevel))
-	largestOffset := math.Min(5-maxSkilllevel, float64(maxAdjust))
-	largestOffset = math.Max(largestOffset, 0)
-	return rand.Intn(int(largestOffset))
+	return skillAdjust(player.random(), player.SkillLevel, maxAdjust)
+}
+
+// random returns the player's random source, creating a time seeded one if needed
+func (player *Player) random() *rand.Rand {
+	if player.Random == nil {
+		player.Random = newRandom()
+	}
+	return player.Random
 }
@@ -31,16 +31,28 @@ func TestTakeDeal(t *testing.T) {
 		t.Errorf("Error dealing cards from deck: %v", err)
 		return
 	}
-	player.TakeDeal(hands[0], &deck, true)
+	err = player.TakeDeal(hands[0], &deck, true)
+	if err != nil {
+		t.Errorf("Error with computer taking deal: %v", err)
+	}
 	if len(player.Discard.Discarded) != 2 {
 		t.Errorf("Error with computer taking deal, got %v discarded, want 2", len(player.Discard.Discarded))
 	}
 	// Human
 	player.IsComputer = false
-	player.TakeDeal(hands[0], &deck, false)
+	err = player.TakeDeal(hands[0], &deck, false)
+	if err != nil {
+		t.Errorf("Error with human taking deal: %v", err)
+	}
 	if len(player.Discard.Discarded) != 0 {
 		t.Errorf("Error with computer taking deal, got %v discarded, want 0", len(player.Discard.Discarded))
 	}
+	// Invalid discard
+	player = poner.Player{Name: "Test", IsComputer: true, Strategy: cheatingStrategy{}}
+	err = player.TakeDeal(hands[0], &deck, false)
+	if err == nil {
+		t.Errorf("Error with computer taking deal, did not get err for discard %v", player.Discard)
+	}
 }
 
 func TestSkillAdjust(t *testing.T) {
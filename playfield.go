@@ -1,14 +1,10 @@
 package poner
 
-import (
-	"fmt"
-	"sort"
-)
-
 // CardPlay holds the ranking of a card play
 type CardPlay struct {
-	Card  Card
-	Value int
+	Card     Card
+	Value    int
+	Expected float64
 }
 
 // CardPlays is a group of CardPlays for sorting
@@ -28,8 +24,7 @@ func (plays CardPlays) Less(ii, jj int) bool {
 
 // CanBePlayed returns whether a card can be played
 func (card Card) CanBePlayed(field Hand) bool {
-	total := field.GetTotal()
-	return card.Value+total <= 31
+	return SixCardRules.CanBePlayed(card, field)
 }
 
 // TotalWouldBe returns what the total would be if the card was played
@@ -44,24 +39,12 @@ func (card Card) WouldScore(field Hand) (scores []Score) {
 
 // CanPlay returns whether a hand has a playable card
 func (hand Hand) CanPlay(field Hand) bool {
-	for _, card := range hand {
-		if card.CanBePlayed(field) {
-			return true
-		}
-	}
-	return false
+	return SixCardRules.CanPlay(hand, field)
 }
 
 // Play puts a card into the playfield
 func (hand Hand) Play(card Card) (field Hand, scores []Score, err error) {
-	field = hand
-	if !card.CanBePlayed(hand) {
-		err = fmt.Errorf("Play:: %v can't be played. Total would be %v", card, card.TotalWouldBe(hand))
-	}
-
-	field = append(field, card)
-	scores = field.FieldScore()
-	return
+	return SixCardRules.Play(hand, card)
 }
 
 // BuildFieldPairings returns all the pairings from the playfield
@@ -82,48 +65,22 @@ func (hand Hand) BuildFieldPairings() (pairings Pairings) {
 
 // FieldScore returns the scores in the playfield
 func (hand Hand) FieldScore() (scores []Score) {
-	scores = []Score{}
-	pairings := hand.BuildFieldPairings()
-	scores = append(scores, pairings.OfAKindScores()...)
-	scores = append(scores, pairings.RunScores()...)
-
-	total := hand.GetTotal()
-	if total == 15 {
-		scores = append(scores, fifteen.AddPairing(hand))
-	} else if total == 31 {
-		scores = append(scores, thirtyOne.AddPairing(hand))
-	}
-	return
+	return SixCardRules.FieldScore(hand)
 }
 
 // GetPlays gets all available plays
-func (hand Hand) GetPlays(field Hand, nextPlayer Player) (plays CardPlays, cantPlay bool) {
-	plays = CardPlays{}
-	for _, card := range hand {
-		if card.CanBePlayed(field) {
-			plays = append(plays, CardPlay{Card: card})
-		}
-	}
-	if len(plays) == 0 {
-		cantPlay = true
-	}
-
-	for ii := range plays {
-		play := &plays[ii]
-		play.CalculateValue(field, nextPlayer)
-	}
-	sort.Sort(CardPlays(plays))
-
-	return
+func (hand Hand) GetPlays(field Hand, nextPlayer PublicPlayer) (plays CardPlays, cantPlay bool) {
+	return SixCardRules.GetPlays(hand, field, nextPlayer)
 }
 
-// CalculateValue computes the value of a potential card play
-func (play *CardPlay) CalculateValue(field Hand, nextPlayer Player) {
+// CalculateValue computes the value of a potential card play, avoiding plays
+// the next player's played cards would score on
+func (play *CardPlay) CalculateValue(field Hand, nextPlayer PublicPlayer) {
 	playValue := 0
 
 	// Bad moves
 	newField := append(field, play.Card)
-	for _, card := range nextPlayer.Discard.Played {
+	for _, card := range nextPlayer.Played {
 		scores := card.WouldScore(newField)
 		if len(scores) > 0 {
 			playValue--
@@ -153,7 +110,7 @@ func (play *CardPlay) CalculateValue(field Hand, nextPlayer Player) {
 }
 
 // GetBestPlay gets the best card to play
-func (hand Hand) GetBestPlay(field Hand, nextPlayer Player) (bestCard Card, cantPlay bool) {
+func (hand Hand) GetBestPlay(field Hand, nextPlayer PublicPlayer) (bestCard Card, cantPlay bool) {
 	plays, cantPlay := hand.GetPlays(field, nextPlayer)
 	if cantPlay {
 		return
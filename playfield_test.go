@@ -232,7 +232,7 @@ func TestGetBestPlay(t *testing.T) {
 		t.Errorf("Error pulling cards from deck: %v", err)
 		return
 	}
-	_, cantPlay := hand.GetBestPlay(field, player)
+	_, cantPlay := hand.GetBestPlay(field, player.Public())
 	if !cantPlay {
 		t.Error("Error getting best play, got canPlay, want cantPlay")
 	}
@@ -242,7 +242,7 @@ func TestGetBestPlay(t *testing.T) {
 		t.Errorf("Error pulling cards from deck: %v", err)
 		return
 	}
-	bestCard, cantPlay := hand.GetBestPlay(field, player)
+	bestCard, cantPlay := hand.GetBestPlay(field, player.Public())
 	if cantPlay {
 		t.Error("Error getting best play, got cantPlay, want canPlay")
 	}
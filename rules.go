@@ -0,0 +1,323 @@
+package poner
+
+import (
+	"fmt"
+	"sort"
+)
+
+// Rules are the parameters of a cribbage variant: how many cards each player
+// keeps after discarding, the size of the crib, the count the pegging can't
+// pass, the default points to win, whether the pegging stops after the first
+// count, what the first pone pegs at the start of the game, whether a crib or
+// hand flush needs the starter, what nobs and his heels are worth, and what a
+// hand that scores nothing, called nineteen, is worth. Start from a preset
+// and change what the variant needs. In lowball the first player to
+// reach the points to win loses. A game with zero Rules plays six-card
+// cribbage
+type Rules struct {
+	Name             string `json:"name"`
+	HandSize         int    `json:"handSize"`
+	CribSize         int    `json:"cribSize"`
+	PegLimit         int    `json:"pegLimit"`
+	ToWin            int    `json:"toWin"`
+	SingleCount      bool   `json:"singleCount"`
+	PoneStart        int    `json:"poneStart"`
+	CribFlushStarter bool   `json:"cribFlushStarter"`
+	HandFlushStarter bool   `json:"handFlushStarter"`
+	Nobs             int    `json:"nobs"`
+	HisHeels         int    `json:"hisHeels"`
+	Nineteen         int    `json:"nineteen"`
+	Lowball          bool   `json:"lowball"`
+}
+
+// The rules presets
+var (
+	// SixCardRules is standard cribbage, with four card hands to 121
+	SixCardRules = Rules{Name: "Six-Card", HandSize: 4, CribSize: 4, PegLimit: 31, ToWin: 121,
+		CribFlushStarter: true, Nobs: 1, HisHeels: 2}
+	// FiveCardRules is classic five-card cribbage, with three card hands, three
+	// for last and a single count to 61
+	FiveCardRules = Rules{Name: "Five-Card", HandSize: 3, CribSize: 4, PegLimit: 31, ToWin: 61,
+		SingleCount: true, PoneStart: 3, CribFlushStarter: true, Nobs: 1, HisHeels: 2}
+	// SevenCardRules is seven-card cribbage, with five card hands to 181
+	SevenCardRules = Rules{Name: "Seven-Card", HandSize: 5, CribSize: 4, PegLimit: 31, ToWin: 181,
+		CribFlushStarter: true, Nobs: 1, HisHeels: 2}
+	// LowballRules is reverse cribbage, played by six-card rules where the
+	// first player to 121 loses
+	LowballRules = Rules{Name: "Lowball", HandSize: 4, CribSize: 4, PegLimit: 31, ToWin: 121,
+		CribFlushStarter: true, Nobs: 1, HisHeels: 2, Lowball: true}
+)
+
+var rulesPresets = []Rules{SixCardRules, FiveCardRules, SevenCardRules, LowballRules}
+
+// PresetRules returns the preset with a name
+func PresetRules(name string) (rules Rules, found bool) {
+	for _, preset := range rulesPresets {
+		if preset.Name == name {
+			return preset, true
+		}
+	}
+	return
+}
+
+// orDefault returns the rules, or six-card rules for zero Rules
+func (rules Rules) orDefault() Rules {
+	if rules.HandSize == 0 {
+		return SixCardRules
+	}
+	return rules
+}
+
+// Validate returns an error for rules that can't be played by players
+func (rules Rules) Validate(players int) error {
+	if rules.HandSize < 3 || rules.HandSize > 5 {
+		return fmt.Errorf("Validate:: hands of %v cards can't be scored, want 3 to 5", rules.HandSize)
+	}
+	if rules.CribSize < 3 || rules.CribSize > 5 || rules.CribSize < players*rules.Discards(players) {
+		return fmt.Errorf("Validate:: a crib of %v cards can't be made by %v players", rules.CribSize, players)
+	}
+	if rules.PegLimit < 1 || rules.ToWin < 1 {
+		return fmt.Errorf("Validate:: invalid peg limit %v or points to win %v", rules.PegLimit, rules.ToWin)
+	}
+	if players*rules.Dealt(players)+rules.CribSize+1 > 52 {
+		return fmt.Errorf("Validate:: not enough cards to deal %v players", players)
+	}
+	return nil
+}
+
+// Discards returns how many cards each player discards to the crib
+func (rules Rules) Discards(players int) int {
+	if players > 2 {
+		return 1
+	}
+	return 2
+}
+
+// Dealt returns how many cards each player is dealt
+func (rules Rules) Dealt(players int) int {
+	return rules.HandSize + rules.Discards(players)
+}
+
+// cribCards returns how many cards are dealt straight to the crib, which
+// makes up the crib when the discards are short of it
+func (rules Rules) cribCards(players int) int {
+	cards := rules.CribSize - players*rules.Discards(players)
+	if cards < 0 {
+		return 0
+	}
+	return cards
+}
+
+// Score scores a hand or crib of three to five cards
+func (rules Rules) Score(hand Hand, starter Card, isCrib bool) (scores []Score, total int) {
+	grossScores := []Score{}
+	if len(hand) < 3 || len(hand) > 5 {
+		return
+	}
+	// Build a new hand, as scoring runs sorts it and appending could
+	// reorder the cards of the hand being scored
+	sizedHand := make(Hand, len(hand)+1)
+	copy(sizedHand, hand)
+	sizedHand[len(hand)] = starter
+	pairings := sizedHand.BuildPairings()
+
+	grossScores = append(grossScores, rules.value(hand.NobsScore(starter), rules.Nobs))
+	grossScores = append(grossScores, pairings.OfAKindScores()...)
+	grossScores = append(grossScores, pairings.FifteenScores()...)
+	grossScores = append(grossScores, pairings.RunScores()...)
+	grossScores = append(grossScores, hand.FlushScore(starter, rules.HandFlushStarter || (isCrib && rules.CribFlushStarter)))
+
+	scores = []Score{}
+	for _, score := range grossScores {
+		if score.Value > 0 {
+			total += score.Value
+			scores = append(scores, score)
+		}
+	}
+	if total == 0 && rules.Nineteen > 0 {
+		score := nineteen.AddPairing(sizedHand)
+		score.Value = rules.Nineteen
+		scores, total = []Score{score}, score.Value
+	}
+
+	return
+}
+
+// AverageScore returns the average score of a hand over the ranks of the
+// starters left in the deck. The starters have no suit, so nobs and flushes
+// with the starter aren't counted
+func (rules Rules) AverageScore(hand Hand, deck *Deck) float32 {
+	mask, err := hand.Mask()
+	frequencyTotal, cards := 0, 0
+	for _, frequency := range deck.Frequencies {
+		total := 0
+		if err == nil {
+			total = rules.maskScore(mask, frequency.Order, noSuit, false)
+		} else {
+			_, total = rules.Score(hand, Card{Name: frequency.Name, Value: frequency.Value, Order: frequency.Order}, false)
+		}
+		frequencyTotal += total * frequency.Count
+		cards += frequency.Count
+	}
+	if cards == 0 {
+		return 0
+	}
+	return float32(frequencyTotal) / float32(cards)
+}
+
+// HisHeelsScore checks the starter for his heels
+func (rules Rules) HisHeelsScore(starter Card) Score {
+	return rules.value(starter.HisHeelsScore(), rules.HisHeels)
+}
+
+// PoneStartScore returns the points the first pone pegs at the start of the game
+func (rules Rules) PoneStartScore() (score Score) {
+	if rules.PoneStart > 0 {
+		score = threeForLast
+		score.Value = rules.PoneStart
+	}
+	return
+}
+
+// value sets what a score found by the standard rules is worth
+func (rules Rules) value(score Score, value int) Score {
+	if score.Value == 0 || value <= 0 {
+		return Score{}
+	}
+	score.Value = value
+	return score
+}
+
+// CanBePlayed returns whether a card can be played onto the field
+func (rules Rules) CanBePlayed(card Card, field Hand) bool {
+	return card.TotalWouldBe(field) <= rules.PegLimit
+}
+
+// CanPlay returns whether a hand has a card that can be played onto the field
+func (rules Rules) CanPlay(hand Hand, field Hand) bool {
+	for _, card := range hand {
+		if rules.CanBePlayed(card, field) {
+			return true
+		}
+	}
+	return false
+}
+
+// Play puts a card into the field
+func (rules Rules) Play(field Hand, card Card) (played Hand, scores []Score, err error) {
+	played = field
+	if !rules.CanBePlayed(card, field) {
+		err = fmt.Errorf("Play:: %v can't be played. Total would be %v", card, card.TotalWouldBe(field))
+		return
+	}
+
+	played = append(field, card)
+	scores = rules.FieldScore(played)
+	return
+}
+
+// FieldScore returns the scores in the field, pegging two for reaching the peg limit
+func (rules Rules) FieldScore(field Hand) (scores []Score) {
+	scores = []Score{}
+	pairings := field.BuildFieldPairings()
+	scores = append(scores, pairings.OfAKindScores()...)
+	scores = append(scores, pairings.RunScores()...)
+
+	total := field.GetTotal()
+	if total == 15 {
+		scores = append(scores, fifteen.AddPairing(field))
+	}
+	if total == rules.PegLimit {
+		scores = append(scores, thirtyOne.AddPairing(field))
+	}
+	return
+}
+
+// BuildPossibleHolds returns all the different discard options for a hand
+// that keep the rules' hand size
+func (rules Rules) BuildPossibleHolds(hand Hand, deck *Deck, playersCrib bool) (discards []Discard) {
+	discards = []Discard{}
+	if rules.HandSize < 1 || rules.HandSize > len(hand) {
+		return
+	}
+	forEachCombination(len(hand), rules.HandSize, func(indexes []int) {
+		held, discarded := Hand{}, Hand{}
+		for ii, card := range hand {
+			if len(held) < len(indexes) && indexes[len(held)] == ii {
+				held = append(held, card)
+			} else {
+				discarded = append(discarded, card)
+			}
+		}
+		discards = append(discards, rules.BuildDiscard(held, discarded, deck, playersCrib))
+	})
+	return
+}
+
+// BuildDiscard builds a discard with the average scores of its held and
+// discarded cards. The crib averages come from tables of standard play when
+// the rules score the crib the standard way, otherwise from the exact crib
+// expectation over the cards left in the deck
+func (rules Rules) BuildDiscard(held Hand, discarded Hand, deck *Deck, playersCrib bool) (discard Discard) {
+	discard = Discard{
+		Held:        held,
+		Discarded:   discarded,
+		Played:      Hand{},
+		HeldAverage: rules.AverageScore(held, deck),
+	}
+	if len(discarded) > 0 && !rules.standardCrib() {
+		discard.DiscardedAverage = float32(rules.CribExpectation(discarded, Hand(deck.Cards)))
+		return
+	}
+	switch len(discarded) {
+	case 1:
+		discard.DiscardedAverage = singleCribDiscards[discarded[0].Order]
+	case 2:
+		if playersCrib {
+			discard.DiscardedAverage = playerCribDiscards[discarded[0].Order][discarded[1].Order]
+		} else {
+			discard.DiscardedAverage = opponentCribDiscards[discarded[0].Order][discarded[1].Order]
+		}
+	}
+	return
+}
+
+// standardCrib returns whether the rules score a four card crib like six-card
+// cribbage, which the crib tables were built for
+func (rules Rules) standardCrib() bool {
+	return rules.CribSize == SixCardRules.CribSize && rules.CribFlushStarter == SixCardRules.CribFlushStarter &&
+		rules.Nobs == SixCardRules.Nobs && rules.Nineteen == SixCardRules.Nineteen
+}
+
+// GetHolds returns the possible discards for a hand, sorted by best first.
+// For lowball the best discards keep the fewest points
+func (rules Rules) GetHolds(hand Hand, deck *Deck, playersCrib bool) (discards []Discard) {
+	discards = rules.BuildPossibleHolds(hand, deck, playersCrib)
+	sortDiscards(discards, playersCrib, rules.Lowball)
+	return
+}
+
+// GetPlays gets the cards of a hand that can be played, ranked best first.
+// For lowball the best plays are the ones least likely to peg
+func (rules Rules) GetPlays(hand Hand, field Hand, nextPlayer PublicPlayer) (plays CardPlays, cantPlay bool) {
+	plays = CardPlays{}
+	for _, card := range hand {
+		if rules.CanBePlayed(card, field) {
+			plays = append(plays, CardPlay{Card: card})
+		}
+	}
+	if len(plays) == 0 {
+		cantPlay = true
+	}
+
+	for ii := range plays {
+		play := &plays[ii]
+		play.CalculateValue(field, nextPlayer)
+		if rules.Lowball {
+			play.Value = -play.Value
+		}
+	}
+	sort.Sort(CardPlays(plays))
+
+	return
+}
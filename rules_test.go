@@ -0,0 +1,340 @@
+package poner_test
+
+import (
+	"bytes"
+	"encoding/json"
+	"strings"
+	"testing"
+
+	"github.com/blakecallens/poner"
+)
+
+func TestPresetRules(t *testing.T) {
+	for _, preset := range []poner.Rules{poner.SixCardRules, poner.FiveCardRules, poner.SevenCardRules,
+		poner.LowballRules} {
+		rules, found := poner.PresetRules(preset.Name)
+		if !found || rules != preset {
+			t.Errorf("Error finding preset %v, got %v", preset.Name, rules)
+		}
+	}
+	_, found := poner.PresetRules("Nine-Card")
+	if found {
+		t.Error("Error finding preset, found Nine-Card")
+	}
+}
+
+func TestRulesValidate(t *testing.T) {
+	tests := []struct {
+		update  func(rules *poner.Rules)
+		players int
+		valid   bool
+	}{
+		{func(rules *poner.Rules) {}, 2, true},
+		{func(rules *poner.Rules) {}, 4, true},
+		{func(rules *poner.Rules) { rules.HandSize = 6 }, 2, false},
+		{func(rules *poner.Rules) { rules.CribSize = 3 }, 2, false},
+		{func(rules *poner.Rules) { rules.CribSize = 5 }, 3, true},
+		{func(rules *poner.Rules) { rules.PegLimit = 0 }, 2, false},
+		{func(rules *poner.Rules) { rules.HandSize, rules.CribSize = 5, 5 }, 4, true},
+	}
+	for _, test := range tests {
+		rules := poner.SixCardRules
+		test.update(&rules)
+		err := rules.Validate(test.players)
+		if (err == nil) != test.valid {
+			t.Errorf("Error validating %v for %v players, got %v", rules, test.players, err)
+		}
+	}
+
+	game := poner.Game{Rules: poner.Rules{Name: "Broken", HandSize: 2}}
+	game.New([]poner.Player{{Name: "Bob", IsComputer: true}, {Name: "Sue", IsComputer: true}})
+	_, err := game.Apply(poner.Action{Type: poner.ActionDeal, Player: game.ActivePlayer})
+	if err == nil {
+		t.Error("Error dealing, did not get err for invalid rules")
+	}
+}
+
+func TestRulesScore(t *testing.T) {
+	deck := poner.Deck{}.New()
+	hand, err := deck.PullCards("Jh 2c 3d 9s")
+	if err != nil {
+		t.Errorf("Error pulling cards from deck: %v", err)
+		return
+	}
+	starter, err := deck.PullCard("K", "h")
+	if err != nil {
+		t.Errorf("Error pulling card from deck: %v", err)
+		return
+	}
+	rules := poner.SixCardRules
+	_, total := rules.Score(hand, starter, false)
+	if total != 5 {
+		t.Errorf("Error scoring, got %v, want 5", total)
+	}
+	rules.Nobs = 2
+	_, total = rules.Score(hand, starter, false)
+	if total != 6 {
+		t.Errorf("Error scoring two for nobs, got %v, want 6", total)
+	}
+	rules.Nobs = 0
+	_, total = rules.Score(hand, starter, false)
+	if total != 4 {
+		t.Errorf("Error scoring without nobs, got %v, want 4", total)
+	}
+
+	jack, err := deck.PullCard("J", "s")
+	if err != nil {
+		t.Errorf("Error pulling card from deck: %v", err)
+		return
+	}
+	rules.HisHeels = 3
+	if score := rules.HisHeelsScore(jack); score.Value != 3 {
+		t.Errorf("Error scoring his heels, got %v, want 3", score.Value)
+	}
+	rules.HisHeels = 0
+	if score := rules.HisHeelsScore(jack); score.Value != 0 {
+		t.Errorf("Error scoring his heels, got %v, want 0", score.Value)
+	}
+
+	field, err := deck.PullCards("Ks Qd")
+	if err != nil {
+		t.Errorf("Error pulling cards from deck: %v", err)
+		return
+	}
+	rules.PegLimit = 25
+	_, scores, err := rules.Play(field, hand[0])
+	if err == nil {
+		t.Errorf("Error playing past the peg limit, got %v", scores)
+	}
+	_, scores, err = rules.Play(field, hand[1])
+	if err != nil || len(scores) != 0 {
+		t.Errorf("Error playing, got %v and %v", scores, err)
+	}
+}
+
+func TestSevenCard(t *testing.T) {
+	players := []poner.Player{
+		{Name: "Bob", IsComputer: true, SkillLevel: 4},
+		{Name: "Sue", IsComputer: true, SkillLevel: 4},
+	}
+	rules := poner.SevenCardRules
+	rules.Nobs = 2
+	game := poner.Game{Rules: rules}
+	game.SetSeed(7)
+	game.New(players)
+	if game.ToWin != 181 {
+		t.Errorf("Error starting seven-card game, got %v points to win, want 181", game.ToWin)
+	}
+
+	playUntil(t, &game, func(game *poner.Game) bool {
+		if game.Phase == poner.PhasePegging && len(game.Field) == 0 {
+			for _, player := range game.Players {
+				if len(player.DealtHand) != 7 || len(player.Discard.Held) != 5 || len(game.Crib) != 4 {
+					t.Fatalf("Error dealing, got %v dealt, %v held and crib %v",
+						player.DealtHand, player.Discard.Held, game.Crib)
+				}
+			}
+		}
+		return game.Phase == poner.PhaseGameOver
+	})
+
+	written := bytes.Buffer{}
+	err := game.WriteNotation(&written)
+	if err != nil {
+		t.Errorf("Error writing notation: %v", err)
+		return
+	}
+	if !strings.Contains(written.String(), "[Variant \"Seven-Card\"]\n[Nobs \"2\"]\n") {
+		t.Errorf("Error writing rules tags, got\n%v", written.String())
+	}
+	events, err := poner.ParseNotation(strings.NewReader(written.String()))
+	if err != nil {
+		t.Errorf("Error parsing notation: %v\n%v", err, written.String())
+		return
+	}
+	replayed, err := poner.Replay(events, len(events))
+	if err != nil {
+		t.Errorf("Error replaying notation: %v", err)
+		return
+	}
+	if replayed.Rules != rules {
+		t.Errorf("Error replaying rules, got %v, want %v", replayed.Rules, rules)
+	}
+	for ii, player := range replayed.Players {
+		if player.Score != game.Players[ii].Score {
+			t.Errorf("Error replaying notation, got score %v, want %v", player.Score, game.Players[ii].Score)
+		}
+	}
+
+	encoded, err := game.MarshalBinary()
+	if err != nil {
+		t.Errorf("Error encoding game: %v", err)
+		return
+	}
+	decoded := poner.Game{}
+	err = decoded.UnmarshalBinary(encoded)
+	if err != nil || decoded.Rules != rules || *decoded.Events[0].Rules != rules {
+		t.Errorf("Error decoding rules, got %v and %v", decoded.Rules, err)
+	}
+	saved, err := json.Marshal(game)
+	if err != nil {
+		t.Errorf("Error encoding game: %v", err)
+		return
+	}
+	decoded = poner.Game{}
+	err = json.Unmarshal(saved, &decoded)
+	if err != nil || decoded.Rules != rules {
+		t.Errorf("Error decoding rules, got %v and %v", decoded.Rules, err)
+	}
+}
+
+func TestLowball(t *testing.T) {
+	deck := poner.Deck{}.New()
+	hand, err := deck.PullCards("5h 5c 10d Js 2c 8s")
+	if err != nil {
+		t.Errorf("Error pulling cards from deck: %v", err)
+		return
+	}
+	discards := poner.LowballRules.GetHolds(hand, &deck, true)
+	last := discards[len(discards)-1]
+	if discards[0].HeldAverage+discards[0].DiscardedAverage > last.HeldAverage+last.DiscardedAverage {
+		t.Errorf("Error ranking lowball discards, got %v first and %v last", discards[0], last)
+	}
+	best := hand.GetBestDiscard(&deck, true)
+	if last.HeldAverage != best.HeldAverage {
+		t.Errorf("Error ranking lowball discards, got %v last, want %v", last, best)
+	}
+
+	field, err := deck.PullCards("Kh")
+	if err != nil {
+		t.Errorf("Error pulling cards from deck: %v", err)
+		return
+	}
+	plays, _ := poner.LowballRules.GetPlays(poner.Hand{hand[0], hand[4]}, field, poner.PublicPlayer{})
+	if plays[0].Card != hand[4] {
+		t.Errorf("Error ranking lowball plays, got %v first, want %v", plays[0].Card, hand[4])
+	}
+
+	players := []poner.Player{
+		{Name: "Bob", IsComputer: true, SkillLevel: 4},
+		{Name: "Sue", IsComputer: true, SkillLevel: 4},
+		{Name: "Dan", IsComputer: true, SkillLevel: 4},
+	}
+	game := poner.Game{Rules: poner.LowballRules}
+	game.SetSeed(3)
+	game.New(players)
+	playUntilOver(t, &game)
+	losers := 0
+	for _, player := range game.Players {
+		if player.Score >= game.ToWin {
+			losers++
+		}
+		if player.Score < game.Winner.Score {
+			t.Errorf("Error picking lowball winner, got %v, %v scored less", game.Winner.Name, player.Name)
+		}
+	}
+	if losers != 1 || game.Winner.Score >= game.ToWin {
+		t.Errorf("Error picking lowball winner, got %v with %v", game.Winner.Name, game.Winner.Score)
+	}
+}
+
+func TestHouseRules(t *testing.T) {
+	deck := poner.Deck{}.New()
+	hand, err := deck.PullCards("2h 4h 6h 8h")
+	if err != nil {
+		t.Errorf("Error pulling cards from deck: %v", err)
+		return
+	}
+	starter, err := deck.PullCard("K", "s")
+	if err != nil {
+		t.Errorf("Error pulling card from deck: %v", err)
+		return
+	}
+	tests := []struct {
+		update func(rules *poner.Rules)
+		isCrib bool
+		want   int
+	}{
+		{func(rules *poner.Rules) {}, false, 4},
+		{func(rules *poner.Rules) {}, true, 0},
+		{func(rules *poner.Rules) { rules.CribFlushStarter = false }, true, 4},
+		{func(rules *poner.Rules) { rules.HandFlushStarter = true }, false, 0},
+		{func(rules *poner.Rules) { rules.Nineteen = 1 }, true, 1},
+		{func(rules *poner.Rules) { rules.Nineteen = 1 }, false, 4},
+	}
+	for _, test := range tests {
+		rules := poner.SixCardRules
+		test.update(&rules)
+		scores, total := rules.Score(hand, starter, test.isCrib)
+		if total != test.want {
+			t.Errorf("Error scoring %v with %+v, got %v, want %v", scores, rules, total, test.want)
+		}
+	}
+	rules := poner.SixCardRules
+	rules.Nineteen = 2
+	scores, _ := rules.Score(hand, starter, true)
+	if len(scores) != 1 || scores[0].Name != "Nineteen" || len(scores[0].Pairing) != 5 {
+		t.Errorf("Error naming nineteen, got %v", scores)
+	}
+
+	// Holding a jack is worth more with two for nobs
+	jack, err := deck.PullCard("J", "h")
+	if err != nil {
+		t.Errorf("Error pulling cards from deck: %v", err)
+		return
+	}
+	held := poner.Hand{hand[0], hand[1], hand[2], jack}
+	rules = poner.SixCardRules
+	rules.Nobs = 2
+	standard, twoForNobs := held.ExpectedScore(deck.Cards), rules.ExpectedScore(held, deck.Cards)
+	if twoForNobs <= standard {
+		t.Errorf("Error averaging with two for nobs, got %v, want more than %v", twoForNobs, standard)
+	}
+
+	// Hand flushes need the starter, which the averages by rank don't have
+	rules = poner.SixCardRules
+	rules.HandFlushStarter = true
+	discards := rules.GetHolds(append(copyCards(held), hand[3], starter), &deck, true)
+	for _, discard := range discards {
+		if discard.HeldAverage != rules.AverageScore(discard.Held, &deck) {
+			t.Errorf("Error evaluating discard %v, got %v", discard, discard.HeldAverage)
+		}
+	}
+	if flush := rules.AverageScore(held, &deck); flush != held.GetAverageScore(&deck)-4 {
+		t.Errorf("Error averaging flush that needs the starter, got %v, want %v", flush, held.GetAverageScore(&deck)-4)
+	}
+
+	// The exact crib average matches scoring every crib and starter
+	unseen, err := deck.PullCards("Jc 5c 5d 10s 3c Qd As")
+	if err != nil {
+		t.Errorf("Error pulling cards from deck: %v", err)
+		return
+	}
+	discarded := poner.Hand{hand[3], jack}
+	for _, update := range []func(rules *poner.Rules){
+		func(rules *poner.Rules) { rules.Nobs = 2 },
+		func(rules *poner.Rules) { rules.CribFlushStarter = false },
+		func(rules *poner.Rules) { rules.Nineteen = 3 },
+	} {
+		rules := poner.SixCardRules
+		update(&rules)
+		total, count := 0, 0
+		for ii := range unseen {
+			for jj := ii + 1; jj < len(unseen); jj++ {
+				for kk, starter := range unseen {
+					if kk == ii || kk == jj {
+						continue
+					}
+					_, score := rules.Score(append(copyCards(discarded), unseen[ii], unseen[jj]), starter, true)
+					total += score
+					count++
+				}
+			}
+		}
+		want := float64(total) / float64(count)
+		got := rules.CribExpectation(discarded, unseen)
+		if got-want > 0.0001 || want-got > 0.0001 {
+			t.Errorf("Error getting crib expectation with %+v, got %v, want %v", rules, got, want)
+		}
+	}
+}
@@ -0,0 +1,86 @@
+package poner
+
+//go:generate go run ./cmd/scoretable -out scoretable_data.go
+
+import (
+	"bufio"
+	"fmt"
+	"io"
+)
+
+// scoreTableData holds the generated points of the fifteens, of a kinds and
+// runs of every multiset of five ranks, indexed by rankIndex. Suits only
+// decide flushes and nobs, so every suit pattern of a hand and starter shares
+// the entry of its ranks, and the flush and nobs are added from the suits
+var scoreTableData []uint8
+
+// rankCombinations holds n choose k for ranking multisets of five ranks
+var rankCombinations = func() (table [18][6]int) {
+	for n := range table {
+		table[n][0] = 1
+		for k := 1; k < len(table[n]) && k <= n; k++ {
+			table[n][k] = table[n-1][k-1] + table[n-1][k]
+		}
+	}
+	return
+}()
+
+// scoreTableSize is how many multisets of five ranks there are
+var scoreTableSize = rankCombinations[17][5]
+
+// rankIndex returns the position in the score table of five ranks, given by
+// the count of each. The ranks in order, each raised by its position, are a
+// combination of five of 17, which is ranked by the combinatorial number system
+func rankIndex(counts *[13]int) (index int) {
+	card := 0
+	for order, count := range counts {
+		for ; count > 0; count-- {
+			index += rankCombinations[order+card][card+1]
+			card++
+		}
+	}
+	return
+}
+
+// BuildScoreTable scores the fifteens, of a kinds and runs of every multiset
+// of five ranks with Score, for the score table
+func BuildScoreTable() (table []uint8) {
+	table = make([]uint8, scoreTableSize)
+	forEachCombination(17, 5, func(indexes []int) {
+		counts := [13]int{}
+		cards := Hand{}
+		for position, index := range indexes {
+			order := index - position
+			if counts[order] == 4 {
+				return
+			}
+			// Each repeat of a rank gets the next suit, so the cards are different
+			cards = append(cards, cardFromIndex(counts[order]*13+order))
+			counts[order]++
+		}
+		hand, starter := cards[:4], cards[4]
+		_, total := hand.Score(starter, false)
+		total -= hand.NobsScore(starter).Value + hand.FlushScore(starter, false).Value
+		table[rankIndex(&counts)] = uint8(total)
+	})
+	return
+}
+
+// WriteScoreTable writes the score table as generated Go source that the
+// fast scorer looks four card hands up in
+func WriteScoreTable(writer io.Writer, command string) (err error) {
+	buffer := bufio.NewWriter(writer)
+	fmt.Fprintf(buffer, "// Code generated by %v; DO NOT EDIT.\n\n", command)
+	fmt.Fprintf(buffer, "package poner\n\n")
+	fmt.Fprintf(buffer, "func init() {\n\tscoreTableData = []uint8{")
+	for ii, points := range BuildScoreTable() {
+		if ii%32 == 0 {
+			fmt.Fprintf(buffer, "\n\t\t")
+		} else {
+			fmt.Fprintf(buffer, " ")
+		}
+		fmt.Fprintf(buffer, "%v,", points)
+	}
+	fmt.Fprintf(buffer, "\n\t}\n}\n")
+	return buffer.Flush()
+}
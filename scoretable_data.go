@@ -0,0 +1,202 @@
+// Code generated by scoretable -out scoretable_data.go; DO NOT EDIT.
+
+package poner
+
+func init() {
+	scoreTableData = []uint8{
+		0, 12, 8, 8, 12, 0, 12, 15, 16, 15, 12, 8, 16, 16, 8, 8, 15, 8, 12, 12, 0, 12, 6, 4, 6, 12, 6, 10, 10, 15, 4, 10,
+		16, 6, 17, 12, 8, 4, 4, 8, 4, 10, 18, 6, 16, 8, 8, 8, 8, 8, 17, 12, 12, 12, 20, 0, 12, 6, 4, 6, 12, 6, 8, 8,
+		6, 4, 8, 6, 8, 6, 12, 6, 2, 2, 8, 5, 7, 10, 10, 12, 21, 6, 4, 8, 8, 12, 20, 6, 12, 17, 12, 8, 4, 6, 8, 6,
+		7, 8, 4, 8, 8, 8, 4, 4, 10, 12, 20, 8, 8, 16, 8, 10, 8, 10, 8, 14, 10, 14, 8, 17, 10, 20, 20, 20, 20, 0, 12, 6,
+		4, 6, 12, 6, 8, 8, 8, 4, 10, 4, 8, 8, 20, 6, 2, 4, 6, 4, 6, 12, 2, 12, 8, 8, 4, 4, 4, 12, 4, 12, 6, 8,
+		12, 6, 4, 4, 12, 6, 5, 4, 6, 2, 8, 7, 5, 9, 8, 9, 14, 14, 14, 14, 21, 4, 2, 8, 6, 4, 4, 14, 12, 14, 24, 8,
+		8, 8, 23, 20, 10, 8, 8, 8, 6, 9, 6, 8, 8, 18, 4, 4, 4, 4, 11, 8, 8, 4, 6, 8, 4, 4, 8, 8, 4, 8, 12, 12,
+		16, 24, 4, 4, 6, 24, 10, 8, 12, 8, 12, 12, 20, 6, 6, 12, 8, 6, 6, 12, 21, 8, 12, 12, 24, 12, 12, 0, 12, 6, 4, 6,
+		14, 6, 8, 10, 6, 6, 10, 8, 6, 12, 12, 6, 4, 4, 12, 6, 6, 10, 6, 10, 6, 6, 4, 10, 8, 10, 6, 12, 12, 14, 24, 8,
+		6, 6, 6, 4, 7, 4, 6, 8, 12, 2, 2, 4, 7, 6, 14, 4, 6, 12, 12, 4, 6, 4, 6, 8, 12, 4, 2, 12, 6, 8, 8, 14,
+		8, 20, 12, 6, 6, 12, 4, 5, 6, 2, 6, 8, 4, 2, 8, 2, 7, 2, 6, 6, 4, 12, 7, 7, 11, 7, 7, 9, 6, 8, 9, 16,
+		8, 10, 12, 14, 17, 8, 8, 12, 4, 8, 8, 2, 6, 4, 6, 8, 12, 12, 14, 16, 6, 12, 12, 6, 15, 12, 14, 8, 6, 8, 8, 7,
+		4, 6, 8, 8, 8, 4, 8, 8, 5, 4, 10, 8, 8, 20, 8, 8, 4, 8, 6, 12, 4, 2, 9, 8, 6, 4, 12, 4, 10, 12, 8, 12,
+		4, 6, 4, 4, 6, 2, 8, 10, 12, 12, 12, 16, 6, 12, 6, 4, 16, 8, 20, 12, 8, 12, 6, 8, 12, 6, 6, 14, 12, 6, 12, 6,
+		8, 12, 12, 6, 6, 15, 8, 24, 12, 12, 12, 12, 12, 0, 12, 6, 4, 8, 12, 6, 10, 10, 12, 8, 10, 8, 12, 6, 12, 8, 6, 6,
+		6, 4, 8, 12, 8, 12, 12, 4, 6, 4, 6, 12, 12, 6, 6, 14, 12, 12, 6, 6, 12, 4, 5, 8, 4, 4, 6, 4, 4, 6, 5, 8,
+		14, 2, 6, 12, 6, 8, 6, 12, 2, 8, 4, 4, 6, 10, 4, 8, 14, 8, 8, 20, 12, 6, 4, 6, 6, 5, 4, 6, 2, 8, 6, 4,
+		2, 4, 7, 6, 6, 2, 6, 6, 8, 4, 8, 4, 2, 2, 7, 7, 8, 12, 4, 6, 2, 12, 8, 12, 8, 4, 8, 4, 8, 6, 2, 6,
+		4, 6, 4, 4, 12, 4, 12, 6, 12, 6, 6, 12, 8, 4, 4, 8, 4, 5, 6, 6, 6, 8, 4, 4, 6, 6, 7, 8, 6, 6, 10, 14,
+		6, 6, 8, 4, 6, 8, 2, 4, 9, 6, 4, 8, 8, 4, 10, 13, 9, 11, 7, 7, 7, 7, 7, 7, 9, 8, 10, 8, 9, 12, 14, 14,
+		12, 10, 12, 17, 12, 8, 8, 8, 6, 8, 8, 6, 8, 12, 8, 8, 10, 6, 8, 16, 16, 12, 12, 14, 20, 18, 12, 12, 12, 12, 21, 20,
+		8, 4, 4, 8, 4, 5, 8, 8, 4, 8, 4, 6, 4, 6, 9, 12, 4, 4, 12, 8, 8, 6, 12, 2, 6, 4, 2, 6, 9, 4, 4, 12,
+		4, 4, 10, 12, 6, 4, 6, 2, 4, 6, 2, 6, 4, 6, 6, 2, 7, 4, 12, 4, 6, 4, 4, 8, 8, 6, 8, 6, 6, 8, 6, 6,
+		10, 10, 6, 10, 8, 6, 8, 16, 14, 12, 12, 14, 20, 14, 12, 12, 12, 12, 24, 20, 8, 6, 8, 6, 6, 8, 6, 6, 12, 8, 6, 12,
+		6, 6, 8, 12, 6, 6, 6, 6, 8, 12, 12, 12, 12, 12, 21, 20, 12, 12, 12, 12, 12, 12, 20, 0, 12, 6, 6, 8, 20, 8, 12, 12,
+		8, 6, 14, 6, 12, 12, 24, 12, 6, 6, 14, 4, 8, 12, 4, 12, 12, 8, 6, 12, 2, 12, 6, 6, 12, 8, 12, 12, 6, 4, 8, 6,
+		7, 2, 6, 4, 12, 8, 4, 6, 5, 6, 12, 4, 8, 8, 6, 12, 6, 4, 6, 4, 6, 8, 4, 8, 4, 14, 8, 8, 8, 20, 8, 4,
+		4, 10, 4, 7, 4, 6, 6, 16, 6, 4, 8, 2, 9, 6, 6, 8, 4, 8, 8, 4, 6, 6, 2, 6, 9, 9, 8, 14, 8, 4, 4, 14,
+		10, 8, 8, 8, 8, 8, 14, 6, 8, 8, 8, 8, 6, 8, 16, 8, 12, 12, 18, 12, 12, 20, 6, 2, 2, 8, 2, 5, 2, 4, 6, 12,
+		4, 2, 8, 2, 5, 4, 4, 8, 4, 12, 6, 4, 2, 4, 2, 8, 2, 2, 5, 4, 6, 2, 6, 2, 8, 6, 4, 8, 2, 4, 6, 2,
+		6, 2, 6, 7, 7, 7, 8, 10, 6, 10, 8, 6, 12, 12, 8, 4, 4, 4, 2, 6, 4, 4, 2, 8, 6, 2, 6, 2, 4, 6, 8, 4,
+		4, 10, 8, 12, 6, 6, 6, 6, 8, 12, 6, 2, 2, 8, 2, 5, 4, 6, 4, 12, 4, 4, 6, 2, 7, 8, 2, 6, 6, 6, 8, 4,
+		6, 2, 2, 4, 2, 4, 5, 2, 6, 6, 2, 2, 8, 8, 4, 4, 4, 2, 6, 4, 4, 4, 4, 6, 4, 2, 7, 4, 10, 6, 8, 6,
+		6, 12, 7, 5, 7, 5, 5, 9, 5, 7, 7, 9, 7, 7, 7, 5, 7, 10, 10, 8, 8, 9, 16, 14, 12, 12, 12, 12, 16, 21, 4, 2,
+		4, 2, 2, 6, 2, 4, 6, 4, 4, 6, 2, 2, 4, 8, 4, 4, 4, 4, 8, 12, 12, 12, 12, 12, 16, 24, 6, 6, 6, 6, 6, 8,
+		21, 12, 8, 4, 4, 12, 4, 9, 4, 8, 8, 20, 8, 6, 12, 2, 9, 8, 4, 12, 4, 8, 12, 6, 4, 6, 2, 8, 6, 6, 5, 4,
+		12, 4, 4, 4, 10, 8, 6, 8, 6, 6, 12, 6, 10, 6, 8, 10, 6, 6, 11, 8, 12, 12, 14, 12, 12, 20, 4, 2, 4, 2, 2, 8,
+		2, 6, 2, 6, 6, 2, 4, 2, 4, 6, 8, 6, 6, 9, 12, 6, 4, 4, 4, 4, 8, 8, 4, 2, 4, 2, 2, 8, 2, 6, 4, 4,
+		6, 4, 2, 2, 4, 8, 6, 6, 6, 6, 12, 10, 10, 10, 10, 10, 16, 20, 4, 4, 4, 4, 4, 8, 20, 8, 8, 6, 8, 6, 6, 14,
+		6, 12, 6, 8, 12, 6, 6, 6, 8, 12, 12, 12, 12, 12, 20, 6, 6, 6, 6, 6, 12, 8, 6, 6, 6, 6, 6, 12, 17, 8, 12, 12,
+		12, 12, 12, 20, 12, 12, 0, 12, 8, 8, 12, 12, 12, 12, 14, 12, 8, 12, 12, 6, 12, 12, 12, 6, 6, 6, 8, 8, 12, 4, 12, 6,
+		12, 6, 4, 6, 10, 4, 12, 6, 8, 12, 8, 4, 6, 8, 6, 7, 8, 4, 8, 8, 8, 4, 4, 7, 8, 12, 8, 6, 10, 8, 8, 6,
+		8, 6, 10, 8, 10, 6, 12, 8, 14, 14, 14, 14, 28, 6, 2, 4, 6, 4, 5, 6, 2, 6, 8, 6, 2, 2, 2, 7, 2, 8, 2, 2,
+		6, 4, 2, 6, 4, 4, 4, 9, 7, 8, 14, 6, 6, 6, 16, 14, 4, 4, 4, 4, 6, 8, 4, 2, 4, 4, 4, 4, 6, 14, 8, 6,
+		6, 12, 6, 8, 12, 6, 2, 4, 6, 4, 5, 6, 2, 8, 6, 6, 2, 4, 4, 5, 2, 8, 4, 4, 12, 4, 4, 4, 4, 6, 8, 4,
+		2, 7, 6, 6, 6, 10, 6, 14, 4, 2, 6, 0, 4, 2, 2, 2, 0, 4, 5, 7, 7, 8, 12, 2, 6, 4, 2, 10, 6, 8, 4, 4,
+		4, 4, 4, 6, 2, 2, 8, 6, 4, 8, 4, 8, 4, 6, 2, 2, 10, 4, 12, 6, 6, 6, 8, 6, 12, 6, 2, 4, 6, 4, 5, 8,
+		4, 6, 6, 6, 4, 2, 4, 7, 6, 6, 2, 6, 6, 6, 4, 8, 2, 6, 4, 4, 4, 7, 4, 6, 10, 6, 6, 14, 6, 2, 2, 2,
+		2, 2, 4, 0, 2, 2, 4, 4, 2, 7, 6, 6, 2, 4, 2, 4, 6, 4, 2, 4, 2, 4, 4, 4, 2, 4, 6, 4, 6, 6, 4, 8,
+		7, 7, 5, 5, 8, 10, 8, 6, 6, 6, 8, 12, 12, 4, 2, 4, 2, 4, 4, 4, 2, 6, 4, 4, 8, 4, 4, 8, 6, 2, 2, 2,
+		4, 4, 6, 6, 6, 6, 8, 12, 12, 6, 6, 6, 6, 8, 6, 12, 12, 6, 2, 4, 8, 4, 7, 6, 4, 8, 12, 8, 4, 6, 2, 7,
+		4, 6, 6, 2, 6, 8, 4, 4, 4, 4, 6, 6, 4, 5, 4, 10, 6, 6, 6, 14, 4, 2, 4, 2, 4, 6, 4, 4, 2, 4, 6, 4,
+		4, 9, 8, 6, 6, 8, 6, 8, 12, 2, 0, 2, 0, 2, 4, 2, 2, 0, 4, 4, 2, 4, 2, 6, 2, 4, 2, 2, 7, 6, 4, 2,
+		2, 2, 4, 4, 6, 5, 3, 5, 3, 5, 7, 5, 5, 5, 5, 7, 7, 5, 5, 9, 7, 5, 5, 5, 7, 9, 6, 6, 6, 6, 8, 9,
+		14, 8, 8, 8, 8, 10, 10, 14, 15, 4, 2, 4, 2, 4, 8, 4, 6, 2, 4, 8, 4, 4, 4, 8, 6, 6, 6, 6, 8, 12, 2, 2,
+		2, 2, 4, 6, 4, 8, 8, 8, 8, 10, 12, 12, 16, 6, 6, 6, 6, 8, 12, 6, 15, 12, 8, 4, 8, 8, 8, 9, 12, 4, 12, 8,
+		12, 6, 4, 6, 9, 4, 12, 4, 4, 8, 8, 6, 8, 6, 10, 8, 10, 6, 9, 8, 12, 12, 12, 12, 22, 4, 2, 4, 2, 6, 4, 6,
+		2, 2, 4, 6, 6, 6, 11, 12, 4, 4, 6, 4, 8, 8, 4, 2, 4, 2, 6, 4, 6, 2, 2, 6, 6, 6, 8, 6, 12, 2, 4, 2,
+		2, 9, 4, 6, 4, 4, 4, 8, 4, 8, 4, 2, 4, 2, 6, 4, 6, 2, 4, 4, 6, 8, 6, 6, 12, 4, 2, 2, 2, 6, 4, 4,
+		4, 4, 4, 8, 7, 8, 4, 4, 4, 4, 8, 4, 8, 8, 4, 2, 4, 2, 6, 6, 6, 4, 2, 4, 8, 6, 6, 6, 12, 4, 4, 4,
+		4, 8, 8, 2, 2, 2, 2, 6, 4, 4, 8, 8, 8, 8, 12, 10, 12, 16, 4, 4, 4, 4, 8, 8, 4, 16, 8, 8, 6, 8, 6, 12,
+		8, 12, 6, 6, 8, 12, 12, 12, 12, 20, 6, 6, 6, 6, 12, 8, 6, 6, 6, 6, 12, 6, 8, 6, 6, 6, 6, 12, 6, 8, 8, 6,
+		6, 6, 6, 12, 8, 6, 15, 8, 12, 12, 12, 12, 20, 12, 12, 12, 12, 0, 12, 8, 8, 12, 12, 12, 12, 14, 12, 8, 12, 12, 6, 12,
+		12, 12, 6, 6, 6, 8, 8, 12, 4, 12, 6, 12, 6, 4, 6, 10, 4, 12, 6, 8, 12, 8, 4, 6, 8, 6, 7, 8, 4, 8, 8, 8,
+		4, 4, 7, 8, 12, 8, 6, 10, 8, 8, 6, 8, 6, 10, 8, 10, 6, 12, 8, 14, 14, 14, 14, 28, 6, 2, 4, 6, 4, 5, 6, 2,
+		6, 8, 6, 2, 2, 2, 7, 2, 8, 2, 2, 6, 4, 2, 6, 4, 4, 4, 9, 7, 8, 14, 6, 6, 6, 16, 14, 4, 4, 4, 4, 6,
+		8, 4, 2, 4, 4, 4, 4, 6, 14, 8, 6, 6, 12, 6, 8, 12, 6, 2, 4, 6, 4, 5, 6, 2, 8, 6, 6, 2, 4, 4, 5, 2,
+		8, 4, 4, 12, 4, 4, 4, 4, 6, 8, 4, 2, 7, 6, 6, 6, 10, 6, 14, 4, 2, 6, 0, 4, 2, 2, 2, 0, 4, 5, 7, 7,
+		8, 12, 2, 6, 4, 2, 10, 6, 8, 4, 4, 4, 4, 4, 6, 2, 2, 8, 6, 4, 8, 4, 8, 4, 6, 2, 2, 10, 4, 12, 6, 6,
+		6, 8, 6, 12, 6, 2, 4, 6, 4, 5, 8, 4, 6, 6, 6, 4, 2, 4, 7, 6, 6, 2, 6, 6, 6, 4, 8, 2, 6, 4, 4, 4,
+		7, 4, 6, 10, 6, 6, 14, 6, 2, 2, 2, 2, 2, 4, 0, 2, 2, 4, 4, 2, 7, 6, 6, 2, 4, 2, 4, 6, 4, 2, 4, 2,
+		4, 4, 4, 2, 4, 6, 4, 6, 6, 4, 8, 7, 7, 5, 5, 8, 10, 8, 6, 6, 6, 8, 12, 12, 4, 2, 4, 2, 4, 4, 4, 2,
+		6, 4, 4, 8, 4, 4, 8, 6, 2, 2, 2, 4, 4, 6, 6, 6, 6, 8, 12, 12, 6, 6, 6, 6, 8, 6, 12, 12, 6, 2, 4, 8,
+		4, 7, 6, 4, 8, 12, 8, 4, 6, 2, 7, 4, 6, 6, 2, 6, 8, 4, 4, 4, 4, 6, 6, 4, 5, 4, 10, 6, 6, 6, 14, 4,
+		2, 4, 2, 4, 6, 4, 4, 2, 4, 6, 4, 4, 9, 8, 6, 6, 8, 6, 8, 12, 2, 0, 2, 0, 2, 4, 2, 2, 0, 4, 4, 2,
+		4, 2, 6, 2, 4, 2, 2, 7, 6, 4, 2, 2, 2, 4, 4, 6, 2, 0, 2, 0, 2, 4, 2, 2, 2, 2, 4, 4, 2, 2, 6, 4,
+		2, 2, 2, 4, 6, 5, 5, 5, 5, 7, 8, 12, 2, 2, 2, 2, 4, 4, 12, 6, 4, 2, 4, 2, 4, 8, 4, 6, 2, 4, 8, 4,
+		4, 4, 8, 6, 6, 6, 6, 8, 12, 2, 2, 2, 2, 4, 6, 4, 2, 2, 2, 2, 4, 6, 10, 4, 6, 6, 6, 6, 8, 12, 6, 6,
+		12, 6, 2, 6, 6, 6, 7, 10, 2, 10, 6, 10, 4, 2, 4, 7, 2, 10, 2, 2, 6, 6, 4, 6, 4, 8, 6, 8, 4, 7, 6, 10,
+		10, 10, 10, 20, 2, 0, 2, 0, 4, 2, 4, 0, 0, 2, 4, 4, 4, 9, 10, 2, 2, 4, 2, 6, 6, 2, 0, 2, 0, 4, 2, 4,
+		0, 0, 4, 4, 4, 6, 4, 10, 0, 2, 0, 0, 7, 2, 4, 2, 2, 2, 6, 2, 6, 2, 0, 2, 0, 4, 2, 4, 0, 2, 2, 4,
+		6, 4, 4, 10, 2, 0, 0, 0, 4, 2, 2, 2, 2, 2, 6, 5, 6, 2, 2, 2, 2, 6, 2, 6, 6, 5, 3, 5, 3, 7, 7, 7,
+		5, 3, 5, 9, 7, 7, 7, 13, 5, 5, 5, 5, 9, 9, 3, 3, 3, 3, 7, 5, 5, 4, 4, 4, 4, 8, 6, 7, 10, 8, 8, 8,
+		8, 12, 12, 8, 10, 15, 4, 2, 4, 2, 8, 4, 8, 2, 2, 4, 8, 8, 8, 8, 16, 2, 2, 2, 2, 8, 4, 2, 2, 2, 2, 8,
+		2, 4, 2, 2, 2, 2, 8, 2, 4, 4, 8, 8, 8, 8, 14, 10, 8, 10, 16, 6, 6, 6, 6, 14, 6, 6, 6, 15, 12, 8, 4, 8,
+		8, 8, 9, 12, 4, 12, 8, 12, 6, 4, 6, 9, 4, 12, 4, 4, 8, 8, 6, 8, 6, 10, 8, 10, 6, 9, 8, 12, 12, 12, 12, 22,
+		4, 2, 4, 2, 6, 4, 6, 2, 2, 4, 6, 6, 6, 11, 12, 4, 4, 6, 4, 8, 8, 4, 2, 4, 2, 6, 4, 6, 2, 2, 6, 6,
+		6, 8, 6, 12, 2, 4, 2, 2, 9, 4, 6, 4, 4, 4, 8, 4, 8, 4, 2, 4, 2, 6, 4, 6, 2, 4, 4, 6, 8, 6, 6, 12,
+		4, 2, 2, 2, 6, 4, 4, 4, 4, 4, 8, 7, 8, 4, 4, 4, 4, 8, 4, 8, 8, 4, 2, 4, 2, 6, 6, 6, 4, 2, 4, 8,
+		6, 6, 6, 12, 4, 4, 4, 4, 8, 8, 2, 2, 2, 2, 6, 4, 4, 2, 2, 2, 2, 6, 4, 7, 4, 4, 4, 4, 4, 8, 8, 4,
+		4, 8, 4, 2, 4, 2, 8, 4, 8, 2, 2, 4, 8, 8, 8, 8, 16, 2, 2, 2, 2, 8, 4, 2, 2, 2, 2, 8, 2, 4, 2, 2,
+		2, 2, 8, 2, 4, 4, 8, 8, 8, 8, 14, 10, 8, 10, 16, 4, 4, 4, 4, 12, 4, 4, 4, 16, 8, 8, 6, 8, 6, 12, 8, 12,
+		6, 6, 8, 12, 12, 12, 12, 20, 6, 6, 6, 6, 12, 8, 6, 6, 6, 6, 12, 6, 8, 6, 6, 6, 6, 12, 6, 8, 8, 6, 6, 6,
+		6, 12, 8, 6, 6, 8, 6, 6, 6, 6, 14, 6, 6, 6, 15, 8, 12, 12, 12, 12, 20, 12, 12, 12, 12, 12, 0, 12, 8, 8, 12, 12,
+		12, 12, 14, 12, 8, 12, 12, 6, 12, 12, 12, 6, 6, 6, 8, 8, 12, 4, 12, 6, 12, 6, 4, 6, 10, 4, 12, 6, 8, 12, 8, 4,
+		6, 8, 6, 7, 8, 4, 8, 8, 8, 4, 4, 7, 8, 12, 8, 6, 10, 8, 8, 6, 8, 6, 10, 8, 10, 6, 12, 8, 14, 14, 14, 14,
+		28, 6, 2, 4, 6, 4, 5, 6, 2, 6, 8, 6, 2, 2, 2, 7, 2, 8, 2, 2, 6, 4, 2, 6, 4, 4, 4, 9, 7, 8, 14, 6,
+		6, 6, 16, 14, 4, 4, 4, 4, 6, 8, 4, 2, 4, 4, 4, 4, 6, 14, 8, 6, 6, 12, 6, 8, 12, 6, 2, 4, 6, 4, 5, 6,
+		2, 8, 6, 6, 2, 4, 4, 5, 2, 8, 4, 4, 12, 4, 4, 4, 4, 6, 8, 4, 2, 7, 6, 6, 6, 10, 6, 14, 4, 2, 6, 0,
+		4, 2, 2, 2, 0, 4, 5, 7, 7, 8, 12, 2, 6, 4, 2, 10, 6, 8, 4, 4, 4, 4, 4, 6, 2, 2, 8, 6, 4, 8, 4, 8,
+		4, 6, 2, 2, 10, 4, 12, 6, 6, 6, 8, 6, 12, 6, 2, 4, 6, 4, 5, 8, 4, 6, 6, 6, 4, 2, 4, 7, 6, 6, 2, 6,
+		6, 6, 4, 8, 2, 6, 4, 4, 4, 7, 4, 6, 10, 6, 6, 14, 6, 2, 2, 2, 2, 2, 4, 0, 2, 2, 4, 4, 2, 7, 6, 6,
+		2, 4, 2, 4, 6, 4, 2, 4, 2, 4, 4, 4, 2, 4, 6, 4, 6, 6, 4, 8, 7, 7, 5, 5, 8, 10, 8, 6, 6, 6, 8, 12,
+		12, 4, 2, 4, 2, 4, 4, 4, 2, 6, 4, 4, 8, 4, 4, 8, 6, 2, 2, 2, 4, 4, 6, 6, 6, 6, 8, 12, 12, 6, 6, 6,
+		6, 8, 6, 12, 12, 6, 2, 4, 8, 4, 7, 6, 4, 8, 12, 8, 4, 6, 2, 7, 4, 6, 6, 2, 6, 8, 4, 4, 4, 4, 6, 6,
+		4, 5, 4, 10, 6, 6, 6, 14, 4, 2, 4, 2, 4, 6, 4, 4, 2, 4, 6, 4, 4, 9, 8, 6, 6, 8, 6, 8, 12, 2, 0, 2,
+		0, 2, 4, 2, 2, 0, 4, 4, 2, 4, 2, 6, 2, 4, 2, 2, 7, 6, 4, 2, 2, 2, 4, 4, 6, 2, 0, 2, 0, 2, 4, 2,
+		2, 2, 2, 4, 4, 2, 2, 6, 4, 2, 2, 2, 4, 6, 5, 5, 5, 5, 7, 8, 12, 2, 2, 2, 2, 4, 4, 12, 6, 4, 2, 4,
+		2, 4, 8, 4, 6, 2, 4, 8, 4, 4, 4, 8, 6, 6, 6, 6, 8, 12, 2, 2, 2, 2, 4, 6, 4, 2, 2, 2, 2, 4, 6, 10,
+		4, 6, 6, 6, 6, 8, 12, 6, 6, 12, 6, 2, 6, 6, 6, 7, 10, 2, 10, 6, 10, 4, 2, 4, 7, 2, 10, 2, 2, 6, 6, 4,
+		6, 4, 8, 6, 8, 4, 7, 6, 10, 10, 10, 10, 20, 2, 0, 2, 0, 4, 2, 4, 0, 0, 2, 4, 4, 4, 9, 10, 2, 2, 4, 2,
+		6, 6, 2, 0, 2, 0, 4, 2, 4, 0, 0, 4, 4, 4, 6, 4, 10, 0, 2, 0, 0, 7, 2, 4, 2, 2, 2, 6, 2, 6, 2, 0,
+		2, 0, 4, 2, 4, 0, 2, 2, 4, 6, 4, 4, 10, 2, 0, 0, 0, 4, 2, 2, 2, 2, 2, 6, 5, 6, 2, 2, 2, 2, 6, 2,
+		6, 6, 2, 0, 2, 0, 4, 4, 4, 2, 0, 2, 6, 4, 4, 4, 10, 2, 2, 2, 2, 6, 6, 0, 0, 0, 0, 4, 2, 2, 3, 3,
+		3, 3, 7, 5, 6, 8, 2, 2, 2, 2, 6, 6, 2, 8, 6, 4, 2, 4, 2, 8, 4, 8, 2, 2, 4, 8, 8, 8, 8, 16, 2, 2,
+		2, 2, 8, 4, 2, 2, 2, 2, 8, 2, 4, 2, 2, 2, 2, 8, 2, 4, 4, 2, 2, 2, 2, 8, 4, 2, 8, 4, 6, 6, 6, 6,
+		14, 6, 6, 6, 6, 12, 6, 2, 6, 6, 6, 7, 10, 2, 10, 6, 10, 4, 2, 4, 7, 2, 10, 2, 2, 6, 6, 4, 6, 4, 8, 6,
+		8, 4, 7, 6, 10, 10, 10, 10, 20, 2, 0, 2, 0, 4, 2, 4, 0, 0, 2, 4, 4, 4, 9, 10, 2, 2, 4, 2, 6, 6, 2, 0,
+		2, 0, 4, 2, 4, 0, 0, 4, 4, 4, 6, 4, 10, 0, 2, 0, 0, 7, 2, 4, 2, 2, 2, 6, 2, 6, 2, 0, 2, 0, 4, 2,
+		4, 0, 2, 2, 4, 6, 4, 4, 10, 2, 0, 0, 0, 4, 2, 2, 2, 2, 2, 6, 5, 6, 2, 2, 2, 2, 6, 2, 6, 6, 2, 0,
+		2, 0, 4, 4, 4, 2, 0, 2, 6, 4, 4, 4, 10, 2, 2, 2, 2, 6, 6, 0, 0, 0, 0, 4, 2, 2, 0, 0, 0, 0, 4, 2,
+		5, 2, 2, 2, 2, 2, 6, 6, 2, 2, 6, 5, 3, 5, 3, 9, 5, 9, 3, 3, 5, 9, 9, 9, 9, 17, 3, 3, 3, 3, 9, 5,
+		3, 3, 3, 3, 9, 3, 5, 3, 3, 3, 3, 9, 3, 5, 5, 4, 4, 4, 4, 10, 6, 4, 5, 10, 8, 8, 8, 8, 16, 8, 8, 8,
+		10, 15, 4, 2, 4, 2, 8, 4, 8, 2, 2, 4, 8, 8, 8, 8, 16, 2, 2, 2, 2, 8, 4, 2, 2, 2, 2, 8, 2, 4, 2, 2,
+		2, 2, 8, 2, 4, 4, 2, 2, 2, 2, 8, 4, 2, 2, 4, 8, 8, 8, 8, 16, 8, 8, 8, 10, 16, 6, 6, 6, 6, 14, 6, 6,
+		6, 6, 15, 12, 8, 4, 8, 8, 8, 9, 12, 4, 12, 8, 12, 6, 4, 6, 9, 4, 12, 4, 4, 8, 8, 6, 8, 6, 10, 8, 10, 6,
+		9, 8, 12, 12, 12, 12, 22, 4, 2, 4, 2, 6, 4, 6, 2, 2, 4, 6, 6, 6, 11, 12, 4, 4, 6, 4, 8, 8, 4, 2, 4, 2,
+		6, 4, 6, 2, 2, 6, 6, 6, 8, 6, 12, 2, 4, 2, 2, 9, 4, 6, 4, 4, 4, 8, 4, 8, 4, 2, 4, 2, 6, 4, 6, 2,
+		4, 4, 6, 8, 6, 6, 12, 4, 2, 2, 2, 6, 4, 4, 4, 4, 4, 8, 7, 8, 4, 4, 4, 4, 8, 4, 8, 8, 4, 2, 4, 2,
+		6, 6, 6, 4, 2, 4, 8, 6, 6, 6, 12, 4, 4, 4, 4, 8, 8, 2, 2, 2, 2, 6, 4, 4, 2, 2, 2, 2, 6, 4, 7, 4,
+		4, 4, 4, 4, 8, 8, 4, 4, 8, 4, 2, 4, 2, 8, 4, 8, 2, 2, 4, 8, 8, 8, 8, 16, 2, 2, 2, 2, 8, 4, 2, 2,
+		2, 2, 8, 2, 4, 2, 2, 2, 2, 8, 2, 4, 4, 2, 2, 2, 2, 8, 4, 2, 5, 4, 4, 4, 4, 4, 12, 4, 4, 4, 4, 8,
+		4, 2, 4, 2, 8, 4, 8, 2, 2, 4, 8, 8, 8, 8, 16, 2, 2, 2, 2, 8, 4, 2, 2, 2, 2, 8, 2, 4, 2, 2, 2, 2,
+		8, 2, 4, 4, 2, 2, 2, 2, 8, 4, 2, 2, 4, 8, 8, 8, 8, 16, 8, 8, 8, 10, 16, 4, 4, 4, 4, 12, 4, 4, 4, 4,
+		16, 8, 8, 6, 8, 6, 12, 8, 12, 6, 6, 8, 12, 12, 12, 12, 20, 6, 6, 6, 6, 12, 8, 6, 6, 6, 6, 12, 6, 8, 6, 6,
+		6, 6, 12, 6, 8, 8, 6, 6, 6, 6, 12, 8, 6, 6, 8, 6, 6, 6, 6, 14, 6, 6, 6, 6, 8, 6, 6, 6, 6, 14, 6, 6,
+		6, 6, 15, 8, 12, 12, 12, 12, 20, 12, 12, 12, 12, 12, 12, 0, 12, 8, 8, 12, 12, 12, 12, 14, 12, 8, 12, 12, 6, 12, 12, 12,
+		6, 6, 6, 8, 8, 12, 4, 12, 6, 12, 6, 4, 6, 10, 4, 12, 6, 8, 12, 8, 4, 6, 8, 6, 7, 8, 4, 8, 8, 8, 4, 4,
+		7, 8, 12, 8, 6, 10, 8, 8, 6, 8, 6, 10, 8, 10, 6, 12, 8, 14, 14, 14, 14, 28, 6, 2, 4, 6, 4, 5, 6, 2, 6, 8,
+		6, 2, 2, 2, 7, 2, 8, 2, 2, 6, 4, 2, 6, 4, 4, 4, 9, 7, 8, 14, 6, 6, 6, 16, 14, 4, 4, 4, 4, 6, 8, 4,
+		2, 4, 4, 4, 4, 6, 14, 8, 6, 6, 12, 6, 8, 12, 6, 2, 4, 6, 4, 5, 6, 2, 8, 6, 6, 2, 4, 4, 5, 2, 8, 4,
+		4, 12, 4, 4, 4, 4, 6, 8, 4, 2, 7, 6, 6, 6, 10, 6, 14, 4, 2, 6, 0, 4, 2, 2, 2, 0, 4, 5, 7, 7, 8, 12,
+		2, 6, 4, 2, 10, 6, 8, 4, 4, 4, 4, 4, 6, 2, 2, 8, 6, 4, 8, 4, 8, 4, 6, 2, 2, 10, 4, 12, 6, 6, 6, 8,
+		6, 12, 6, 2, 4, 6, 4, 5, 8, 4, 6, 6, 6, 4, 2, 4, 7, 6, 6, 2, 6, 6, 6, 4, 8, 2, 6, 4, 4, 4, 7, 4,
+		6, 10, 6, 6, 14, 6, 2, 2, 2, 2, 2, 4, 0, 2, 2, 4, 4, 2, 7, 6, 6, 2, 4, 2, 4, 6, 4, 2, 4, 2, 4, 4,
+		4, 2, 4, 6, 4, 6, 6, 4, 8, 7, 7, 5, 5, 8, 10, 8, 6, 6, 6, 8, 12, 12, 4, 2, 4, 2, 4, 4, 4, 2, 6, 4,
+		4, 8, 4, 4, 8, 6, 2, 2, 2, 4, 4, 6, 6, 6, 6, 8, 12, 12, 6, 6, 6, 6, 8, 6, 12, 12, 6, 2, 4, 8, 4, 7,
+		6, 4, 8, 12, 8, 4, 6, 2, 7, 4, 6, 6, 2, 6, 8, 4, 4, 4, 4, 6, 6, 4, 5, 4, 10, 6, 6, 6, 14, 4, 2, 4,
+		2, 4, 6, 4, 4, 2, 4, 6, 4, 4, 9, 8, 6, 6, 8, 6, 8, 12, 2, 0, 2, 0, 2, 4, 2, 2, 0, 4, 4, 2, 4, 2,
+		6, 2, 4, 2, 2, 7, 6, 4, 2, 2, 2, 4, 4, 6, 2, 0, 2, 0, 2, 4, 2, 2, 2, 2, 4, 4, 2, 2, 6, 4, 2, 2,
+		2, 4, 6, 5, 5, 5, 5, 7, 8, 12, 2, 2, 2, 2, 4, 4, 12, 6, 4, 2, 4, 2, 4, 8, 4, 6, 2, 4, 8, 4, 4, 4,
+		8, 6, 6, 6, 6, 8, 12, 2, 2, 2, 2, 4, 6, 4, 2, 2, 2, 2, 4, 6, 10, 4, 6, 6, 6, 6, 8, 12, 6, 6, 12, 6,
+		2, 6, 6, 6, 7, 10, 2, 10, 6, 10, 4, 2, 4, 7, 2, 10, 2, 2, 6, 6, 4, 6, 4, 8, 6, 8, 4, 7, 6, 10, 10, 10,
+		10, 20, 2, 0, 2, 0, 4, 2, 4, 0, 0, 2, 4, 4, 4, 9, 10, 2, 2, 4, 2, 6, 6, 2, 0, 2, 0, 4, 2, 4, 0, 0,
+		4, 4, 4, 6, 4, 10, 0, 2, 0, 0, 7, 2, 4, 2, 2, 2, 6, 2, 6, 2, 0, 2, 0, 4, 2, 4, 0, 2, 2, 4, 6, 4,
+		4, 10, 2, 0, 0, 0, 4, 2, 2, 2, 2, 2, 6, 5, 6, 2, 2, 2, 2, 6, 2, 6, 6, 2, 0, 2, 0, 4, 4, 4, 2, 0,
+		2, 6, 4, 4, 4, 10, 2, 2, 2, 2, 6, 6, 0, 0, 0, 0, 4, 2, 2, 3, 3, 3, 3, 7, 5, 6, 8, 2, 2, 2, 2, 6,
+		6, 2, 8, 6, 4, 2, 4, 2, 8, 4, 8, 2, 2, 4, 8, 8, 8, 8, 16, 2, 2, 2, 2, 8, 4, 2, 2, 2, 2, 8, 2, 4,
+		2, 2, 2, 2, 8, 2, 4, 4, 2, 2, 2, 2, 8, 4, 2, 8, 4, 6, 6, 6, 6, 14, 6, 6, 6, 6, 12, 6, 2, 6, 6, 6,
+		7, 10, 2, 10, 6, 10, 4, 2, 4, 7, 2, 10, 2, 2, 6, 6, 4, 6, 4, 8, 6, 8, 4, 7, 6, 10, 10, 10, 10, 20, 2, 0,
+		2, 0, 4, 2, 4, 0, 0, 2, 4, 4, 4, 9, 10, 2, 2, 4, 2, 6, 6, 2, 0, 2, 0, 4, 2, 4, 0, 0, 4, 4, 4, 6,
+		4, 10, 0, 2, 0, 0, 7, 2, 4, 2, 2, 2, 6, 2, 6, 2, 0, 2, 0, 4, 2, 4, 0, 2, 2, 4, 6, 4, 4, 10, 2, 0,
+		0, 0, 4, 2, 2, 2, 2, 2, 6, 5, 6, 2, 2, 2, 2, 6, 2, 6, 6, 2, 0, 2, 0, 4, 4, 4, 2, 0, 2, 6, 4, 4,
+		4, 10, 2, 2, 2, 2, 6, 6, 0, 0, 0, 0, 4, 2, 2, 0, 0, 0, 0, 4, 2, 5, 2, 2, 2, 2, 2, 6, 6, 2, 2, 6,
+		2, 0, 2, 0, 6, 2, 6, 0, 0, 2, 6, 6, 6, 6, 14, 0, 0, 0, 0, 6, 2, 0, 0, 0, 0, 6, 0, 2, 0, 0, 0, 0,
+		6, 0, 2, 2, 3, 3, 3, 3, 9, 5, 3, 4, 8, 2, 2, 2, 2, 10, 2, 2, 2, 8, 6, 4, 2, 4, 2, 8, 4, 8, 2, 2,
+		4, 8, 8, 8, 8, 16, 2, 2, 2, 2, 8, 4, 2, 2, 2, 2, 8, 2, 4, 2, 2, 2, 2, 8, 2, 4, 4, 2, 2, 2, 2, 8,
+		4, 2, 2, 4, 2, 2, 2, 2, 10, 2, 2, 2, 8, 4, 6, 6, 6, 6, 14, 6, 6, 6, 6, 6, 12, 6, 2, 6, 6, 6, 7, 10,
+		2, 10, 6, 10, 4, 2, 4, 7, 2, 10, 2, 2, 6, 6, 4, 6, 4, 8, 6, 8, 4, 7, 6, 10, 10, 10, 10, 20, 2, 0, 2, 0,
+		4, 2, 4, 0, 0, 2, 4, 4, 4, 9, 10, 2, 2, 4, 2, 6, 6, 2, 0, 2, 0, 4, 2, 4, 0, 0, 4, 4, 4, 6, 4, 10,
+		0, 2, 0, 0, 7, 2, 4, 2, 2, 2, 6, 2, 6, 2, 0, 2, 0, 4, 2, 4, 0, 2, 2, 4, 6, 4, 4, 10, 2, 0, 0, 0,
+		4, 2, 2, 2, 2, 2, 6, 5, 6, 2, 2, 2, 2, 6, 2, 6, 6, 2, 0, 2, 0, 4, 4, 4, 2, 0, 2, 6, 4, 4, 4, 10,
+		2, 2, 2, 2, 6, 6, 0, 0, 0, 0, 4, 2, 2, 0, 0, 0, 0, 4, 2, 5, 2, 2, 2, 2, 2, 6, 6, 2, 2, 6, 2, 0,
+		2, 0, 6, 2, 6, 0, 0, 2, 6, 6, 6, 6, 14, 0, 0, 0, 0, 6, 2, 0, 0, 0, 0, 6, 0, 2, 0, 0, 0, 0, 6, 0,
+		2, 2, 0, 0, 0, 0, 6, 2, 0, 3, 2, 2, 2, 2, 2, 10, 2, 2, 2, 2, 6, 5, 3, 5, 3, 9, 5, 9, 3, 3, 5, 9,
+		9, 9, 9, 17, 3, 3, 3, 3, 9, 5, 3, 3, 3, 3, 9, 3, 5, 3, 3, 3, 3, 9, 3, 5, 5, 3, 3, 3, 3, 9, 5, 3,
+		3, 5, 4, 4, 4, 4, 12, 4, 4, 4, 5, 10, 8, 8, 8, 8, 16, 8, 8, 8, 8, 10, 15, 4, 2, 4, 2, 8, 4, 8, 2, 2,
+		4, 8, 8, 8, 8, 16, 2, 2, 2, 2, 8, 4, 2, 2, 2, 2, 8, 2, 4, 2, 2, 2, 2, 8, 2, 4, 4, 2, 2, 2, 2, 8,
+		4, 2, 2, 4, 2, 2, 2, 2, 10, 2, 2, 2, 2, 4, 8, 8, 8, 8, 16, 8, 8, 8, 8, 10, 16, 6, 6, 6, 6, 14, 6, 6,
+		6, 6, 6, 15, 12, 8, 4, 8, 8, 8, 9, 12, 4, 12, 8, 12, 6, 4, 6, 9, 4, 12, 4, 4, 8, 8, 6, 8, 6, 10, 8, 10,
+		6, 9, 8, 12, 12, 12, 12, 22, 4, 2, 4, 2, 6, 4, 6, 2, 2, 4, 6, 6, 6, 11, 12, 4, 4, 6, 4, 8, 8, 4, 2, 4,
+		2, 6, 4, 6, 2, 2, 6, 6, 6, 8, 6, 12, 2, 4, 2, 2, 9, 4, 6, 4, 4, 4, 8, 4, 8, 4, 2, 4, 2, 6, 4, 6,
+		2, 4, 4, 6, 8, 6, 6, 12, 4, 2, 2, 2, 6, 4, 4, 4, 4, 4, 8, 7, 8, 4, 4, 4, 4, 8, 4, 8, 8, 4, 2, 4,
+		2, 6, 6, 6, 4, 2, 4, 8, 6, 6, 6, 12, 4, 4, 4, 4, 8, 8, 2, 2, 2, 2, 6, 4, 4, 2, 2, 2, 2, 6, 4, 7,
+		4, 4, 4, 4, 4, 8, 8, 4, 4, 8, 4, 2, 4, 2, 8, 4, 8, 2, 2, 4, 8, 8, 8, 8, 16, 2, 2, 2, 2, 8, 4, 2,
+		2, 2, 2, 8, 2, 4, 2, 2, 2, 2, 8, 2, 4, 4, 2, 2, 2, 2, 8, 4, 2, 5, 4, 4, 4, 4, 4, 12, 4, 4, 4, 4,
+		8, 4, 2, 4, 2, 8, 4, 8, 2, 2, 4, 8, 8, 8, 8, 16, 2, 2, 2, 2, 8, 4, 2, 2, 2, 2, 8, 2, 4, 2, 2, 2,
+		2, 8, 2, 4, 4, 2, 2, 2, 2, 8, 4, 2, 2, 4, 2, 2, 2, 2, 10, 2, 2, 2, 5, 4, 4, 4, 4, 4, 12, 4, 4, 4,
+		4, 4, 8, 4, 2, 4, 2, 8, 4, 8, 2, 2, 4, 8, 8, 8, 8, 16, 2, 2, 2, 2, 8, 4, 2, 2, 2, 2, 8, 2, 4, 2,
+		2, 2, 2, 8, 2, 4, 4, 2, 2, 2, 2, 8, 4, 2, 2, 4, 2, 2, 2, 2, 10, 2, 2, 2, 2, 4, 8, 8, 8, 8, 16, 8,
+		8, 8, 8, 10, 16, 4, 4, 4, 4, 12, 4, 4, 4, 4, 4, 16, 8, 8, 6, 8, 6, 12, 8, 12, 6, 6, 8, 12, 12, 12, 12, 20,
+		6, 6, 6, 6, 12, 8, 6, 6, 6, 6, 12, 6, 8, 6, 6, 6, 6, 12, 6, 8, 8, 6, 6, 6, 6, 12, 8, 6, 6, 8, 6, 6,
+		6, 6, 14, 6, 6, 6, 6, 8, 6, 6, 6, 6, 14, 6, 6, 6, 6, 6, 8, 6, 6, 6, 6, 14, 6, 6, 6, 6, 6, 15, 8, 12,
+		12, 12, 12, 20, 12, 12, 12, 12, 12, 12, 12, 0,
+	}
+}
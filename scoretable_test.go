@@ -0,0 +1,55 @@
+package poner_test
+
+import (
+	"bytes"
+	"io/ioutil"
+	"testing"
+
+	"github.com/blakecallens/poner"
+)
+
+func TestScoreTable(t *testing.T) {
+	table := poner.BuildScoreTable()
+	if len(table) != 6188 {
+		t.Errorf("Error building score table, got %v entries, want 6188", len(table))
+	}
+
+	buffer := bytes.Buffer{}
+	err := poner.WriteScoreTable(&buffer, "scoretable -out scoretable_data.go")
+	if err != nil {
+		t.Errorf("Error writing score table: %v", err)
+		return
+	}
+	generated, err := ioutil.ReadFile("scoretable_data.go")
+	if err != nil {
+		t.Errorf("Error reading score table: %v", err)
+		return
+	}
+	if !bytes.Equal(buffer.Bytes(), generated) {
+		t.Error("Error checking score table, scoretable_data.go is out of date, run go generate")
+	}
+
+	// Every four card hand of the ranks of a deck, with flushes and nobs
+	deck := poner.Deck{}.New()
+	starter := deck.Cards[10]
+	for ii := 0; ii+4 <= len(deck.Cards); ii += 3 {
+		hand := append(poner.Hand{}, deck.Cards[ii:ii+4]...)
+		if hand.Contains(starter) {
+			continue
+		}
+		for _, isCrib := range []bool{false, true} {
+			_, want := hand.Score(starter, isCrib)
+			if total := hand.ScoreTotal(starter, isCrib); total != want {
+				t.Errorf("Error scoring %v %v, got %v, want %v", hand, starter, total, want)
+			}
+		}
+	}
+}
+
+func BenchmarkGetExactDiscards(b *testing.B) {
+	deck := poner.Deck{}.New()
+	hand, _ := deck.PullCards("2c 3c 4d 5h 5c Jc")
+	for ii := 0; ii < b.N; ii++ {
+		hand.GetExactDiscards(poner.Hand(deck.Cards), true)
+	}
+}
@@ -7,9 +7,9 @@ import (
 
 // Score represents a single cribbage score
 type Score struct {
-	Name    string
-	Value   int
-	Pairing Hand
+	Name    string `json:"name"`
+	Value   int    `json:"value"`
+	Pairing Hand   `json:"pairing"`
 }
 
 func (score Score) String() string {
@@ -38,68 +38,34 @@ var (
 	runOfSix        = Score{Name: "Run of Six", Value: 6}
 	runOfSeven      = Score{Name: "Run of Seven", Value: 7}
 	runOfEight      = Score{Name: "Run of Eight", Value: 8}
+	flushOfThree    = Score{Name: "Flush of Three", Value: 3}
 	flushOfFour     = Score{Name: "Flush of Four", Value: 4}
 	flushOfFive     = Score{Name: "Flush of Five", Value: 5}
+	flushOfSix      = Score{Name: "Flush of Six", Value: 6}
 	hisHeels        = Score{Name: "His Heels", Value: 2}
 	goScore         = Score{Name: "Go", Value: 1}
 	thirtyOne       = Score{Name: "Thirty One", Value: 2}
+	threeForLast    = Score{Name: "Three for Last", Value: 3}
+	nineteen        = Score{Name: "Nineteen", Value: 0}
 )
 
-// Score scores a cribbage hand/crib
+// Score scores a cribbage hand or crib of three to five cards with the
+// standard rules
 func (hand Hand) Score(starter Card, isCrib bool) (scores []Score, total int) {
-	grossScores := []Score{}
-	if len(hand) != 4 {
-		return
-	}
-	sizedHand := append(hand[:4], starter)
-	pairings := sizedHand.BuildPairings()
-
-	grossScores = append(grossScores, hand[:4].NobsScore(starter))
-	grossScores = append(grossScores, pairings.OfAKindScores()...)
-	grossScores = append(grossScores, pairings.FifteenScores()...)
-	grossScores = append(grossScores, pairings.RunScores()...)
-	grossScores = append(grossScores, pairings.FlushScores(isCrib)...)
-
-	scores = []Score{}
-	for _, score := range grossScores {
-		if score.Value > 0 {
-			total += score.Value
-			scores = append(scores, score)
-		}
-	}
-
-	return
+	return SixCardRules.Score(hand, starter, isCrib)
 }
 
-// BuildPairings builds all the possible card pairings for a hand
+// BuildPairings builds all the possible card pairings for a hand, largest first
 func (hand Hand) BuildPairings() (pairings Pairings) {
 	pairings = Pairings{hand}
-	// Quadruples
-	for ii := 0; ii < len(hand)-3; ii++ {
-		for jj := ii + 1; jj < len(hand)-2; jj++ {
-			for kk := jj + 1; kk < len(hand)-1; kk++ {
-				for ll := kk + 1; ll < len(hand); ll++ {
-					pairing := Hand{hand[ii], hand[jj], hand[kk], hand[ll]}
-					pairings = append(pairings, pairing)
-				}
+	for size := len(hand) - 1; size >= 2; size-- {
+		forEachCombination(len(hand), size, func(indexes []int) {
+			pairing := make(Hand, size)
+			for ii, index := range indexes {
+				pairing[ii] = hand[index]
 			}
-		}
-	}
-	// Triples
-	for ii := 0; ii < len(hand)-2; ii++ {
-		for jj := ii + 1; jj < len(hand)-1; jj++ {
-			for kk := jj + 1; kk < len(hand); kk++ {
-				pairing := Hand{hand[ii], hand[jj], hand[kk]}
-				pairings = append(pairings, pairing)
-			}
-		}
-	}
-	// Doubles
-	for ii := 0; ii < len(hand)-1; ii++ {
-		for jj := ii + 1; jj < len(hand); jj++ {
-			pairing := Hand{hand[ii], hand[jj]}
 			pairings = append(pairings, pairing)
-		}
+		})
 	}
 	return
 }
@@ -231,37 +197,50 @@ func (pairings Pairings) RunScores() (scores []Score) {
 	return
 }
 
-// FlushScores finds all the flushes in pairings
+// FlushScores finds the flush in pairings built from a hand with the starter last
 func (pairings Pairings) FlushScores(isCrib bool) (scores []Score) {
 	scores = []Score{}
-	for _, pairing := range pairings {
-		if len(pairing) < 4 || (isCrib && len(pairing) < 5) {
-			return
-		}
-		// Check if pairing is a flush
-		suit := pairing[0].Suit
-		isFlush := true
-		for _, card := range pairing {
-			if card.Suit != suit {
-				isFlush = false
-				break
-			}
-		}
-		if !isFlush {
-			continue
-		}
-		switch len(pairing) {
-		case 4:
-			scores = append(scores, flushOfFour.AddPairing(pairing))
-			return
-		case 5:
-			scores = append(scores, flushOfFive.AddPairing(pairing))
+	if len(pairings) == 0 || len(pairings[0]) < 4 {
+		return
+	}
+	full := pairings[0]
+	score := full[:len(full)-1].FlushScore(full[len(full)-1], isCrib)
+	if score.Value > 0 {
+		scores = append(scores, score)
+	}
+	return
+}
+
+// FlushScore checks a hand for a flush. Every card of the hand has to share
+// a suit, and the starter adds a point if it matches. Cribs only score a
+// flush that includes the starter
+func (hand Hand) FlushScore(starter Card, isCrib bool) (score Score) {
+	if len(hand) < 3 {
+		return
+	}
+	for _, card := range hand {
+		if card.Suit != hand[0].Suit {
 			return
-		default:
-			break
 		}
 	}
-	return
+	pairing := copyHand(hand)
+	if starter.Suit == hand[0].Suit {
+		pairing = append(pairing, starter)
+	} else if isCrib {
+		return
+	}
+	switch len(pairing) {
+	case 3:
+		return flushOfThree.AddPairing(pairing)
+	case 4:
+		return flushOfFour.AddPairing(pairing)
+	case 5:
+		return flushOfFive.AddPairing(pairing)
+	case 6:
+		return flushOfSix.AddPairing(pairing)
+	default:
+		return
+	}
 }
 
 // HisHeelsScore checks the starter for his heels (Jack)
@@ -23,7 +23,7 @@ func TestScoreString(t *testing.T) {
 func TestScore(t *testing.T) {
 	deck := poner.Deck{}.New()
 	deck.Shuffle()
-	hand, err := deck.PullCards("Ac 2c 3c")
+	hand, err := deck.PullCards("Ac 2c")
 	if err != nil {
 		t.Errorf("Error pulling cards from deck: %v", err)
 		return
@@ -73,6 +73,55 @@ func TestScore(t *testing.T) {
 	}
 }
 
+func TestFlushScore(t *testing.T) {
+	deck := poner.Deck{}.New()
+	for _, test := range []struct {
+		cards  string
+		isCrib bool
+		want   int
+	}{
+		{"2h 4h 6h 8h Kc", false, 4},
+		{"2h 4h 6h 8h Kc", true, 0},
+		{"2h 4h 6h 8h Kh", true, 5},
+		{"2h 4h 6h 8c Kh", false, 0},
+		{"2h 4h 8h Kc", false, 3},
+		{"2h 4h 8h Kh", false, 4},
+	} {
+		cards, err := deck.PullCards(test.cards)
+		if err != nil {
+			t.Errorf("Error pulling cards from deck: %v", err)
+			return
+		}
+		for _, card := range cards {
+			deck.Cards = append(deck.Cards, card)
+		}
+		hand, starter := cards[:len(cards)-1], cards[len(cards)-1]
+		score := hand.FlushScore(starter, test.isCrib)
+		if score.Value != test.want {
+			t.Errorf("Error scoring flush of %v, got %v, want %v", test.cards, score.Value, test.want)
+		}
+	}
+}
+
+func TestFiveCardScore(t *testing.T) {
+	deck := poner.Deck{}.New()
+	hand, err := deck.PullCards("4h 5h 6h")
+	if err != nil {
+		t.Errorf("Error pulling cards from deck: %v", err)
+		return
+	}
+	starter, err := deck.PullCard("5", "s")
+	if err != nil {
+		t.Errorf("Error pulling card from deck: %v", err)
+		return
+	}
+	// Two fifteens, a pair, two runs of three and a flush of three
+	score, total := hand.Score(starter, false)
+	if len(score) != 6 || total != 15 {
+		t.Errorf("Error getting score, got %v scores for %v, want 6 for 15", len(score), total)
+	}
+}
+
 func TestHisHeels(t *testing.T) {
 	deck := poner.Deck{}.New()
 	deck.Shuffle()
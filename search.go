@@ -0,0 +1,334 @@
+package poner
+
+import (
+	"math"
+	"sort"
+)
+
+// PeggingOpponent is what is known about the opponent for a pegging search
+type PeggingOpponent struct {
+	Hand   Hand
+	Cards  int
+	Gone   bool
+	Unseen Hand
+}
+
+// searchState is a two player pegging position, from the searching player's
+// side. Player 0 is the searching player, player 1 the opponent, whose hand
+// is either known or the counts of the cards they could be holding
+type searchState struct {
+	hands  [2][13]int8
+	cards  [2]int8
+	count  [12]int8
+	length int8
+	total  int8
+	turn   int8
+	gone   [2]bool
+	last   int8
+}
+
+// countKey identifies the ranks played into a count
+type countKey struct {
+	count  [12]int8
+	length int8
+}
+
+// pegSearch searches the rest of a hand's pegging
+type pegSearch struct {
+	known   bool
+	weights [13]float64
+	memo    map[searchState]float64
+	points  map[countKey]int
+}
+
+// GetSearchPlays ranks plays by searching the rest of the hand's pegging. The
+// search is exact when the opponent's hand is known, otherwise it is an
+// expectimax over the cards the opponent could be holding
+func (hand Hand) GetSearchPlays(field Hand, opponent PeggingOpponent) (plays CardPlays, cantPlay bool) {
+	return hand.searchPlays(field, opponent, nil)
+}
+
+// GetBestSearchPlay gets the best card to play from a pegging search
+func (hand Hand) GetBestSearchPlay(field Hand, opponent PeggingOpponent) (bestCard Card, cantPlay bool) {
+	plays, cantPlay := hand.GetSearchPlays(field, opponent)
+	if cantPlay {
+		return
+	}
+
+	bestCard = plays[0].Card
+	return
+}
+
+// searchPlays runs the pegging search, weighting the opponent's unseen ranks if weights are supplied
+func (hand Hand) searchPlays(field Hand, opponent PeggingOpponent, weights []float64) (plays CardPlays, cantPlay bool) {
+	plays = CardPlays{}
+	for _, card := range hand {
+		if card.CanBePlayed(field) {
+			plays = append(plays, CardPlay{Card: card})
+		}
+	}
+	if len(plays) == 0 {
+		cantPlay = true
+		return
+	}
+
+	search := &pegSearch{
+		known:  len(opponent.Hand) > 0 || opponent.Cards == 0,
+		memo:   map[searchState]float64{},
+		points: map[countKey]int{},
+	}
+	for rank := range search.weights {
+		search.weights[rank] = 1
+		if rank < len(weights) {
+			search.weights[rank] = weights[rank]
+		}
+	}
+
+	state := searchState{total: int8(field.GetTotal()), last: 1, gone: [2]bool{false, opponent.Gone}}
+	if opponent.Gone {
+		state.last = 0
+	}
+	for _, card := range field {
+		if int(state.length) < len(state.count) {
+			state.count[state.length] = int8(card.Order)
+			state.length++
+		}
+	}
+	for _, card := range hand {
+		state.hands[0][card.Order]++
+		state.cards[0]++
+	}
+	if search.known {
+		for _, card := range opponent.Hand {
+			state.hands[1][card.Order]++
+			state.cards[1]++
+		}
+	} else {
+		for _, card := range opponent.Unseen {
+			state.hands[1][card.Order]++
+		}
+		state.cards[1] = int8(opponent.Cards)
+	}
+
+	for ii := range plays {
+		play := &plays[ii]
+		play.Expected = search.play(state, play.Card.Order)
+		play.Value = int(math.Round(play.Expected))
+	}
+	sort.SliceStable(plays, func(ii, jj int) bool {
+		if plays[ii].Expected != plays[jj].Expected {
+			return plays[ii].Expected > plays[jj].Expected
+		}
+		return plays[ii].Card.Value > plays[jj].Card.Value
+	})
+	return
+}
+
+// value returns the expected points difference from a position to the end of the pegging
+func (search *pegSearch) value(state searchState) float64 {
+	if value, ok := search.memo[state]; ok {
+		return value
+	}
+	value := search.evaluate(state)
+	search.memo[state] = value
+	return value
+}
+
+// evaluate searches a position that isn't memoized yet
+func (search *pegSearch) evaluate(state searchState) float64 {
+	if state.cards[0] == 0 && state.cards[1] == 0 {
+		if state.length > 0 && state.total != 31 {
+			return state.lastSign()
+		}
+		return 0
+	}
+	player, other := state.turn, 1-state.turn
+	if state.cards[player] == 0 || state.gone[player] {
+		if state.cards[other] == 0 || state.gone[other] {
+			return search.endCount(state)
+		}
+		state.turn = other
+		return search.value(state)
+	}
+	if player == 1 && !search.known {
+		return search.chance(state)
+	}
+
+	best, played := math.Inf(1), false
+	if player == 0 {
+		best = math.Inf(-1)
+	}
+	for rank := range state.hands[player] {
+		if state.hands[player][rank] == 0 || int(state.total)+values[rank] > 31 {
+			continue
+		}
+		played = true
+		value := search.play(state, rank)
+		if (player == 0 && value > best) || (player == 1 && value < best) {
+			best = value
+		}
+	}
+	if !played {
+		state.gone[player] = true
+		state.turn = other
+		return search.value(state)
+	}
+	return best
+}
+
+// chance averages the opponent's possible plays when their hand isn't known
+func (search *pegSearch) chance(state searchState) float64 {
+	unseen, playable, weightTotal := 0, 0, 0.0
+	for rank, count := range state.hands[1] {
+		unseen += int(count)
+		if count > 0 && int(state.total)+values[rank] <= 31 {
+			playable += int(count)
+			weightTotal += float64(count) * search.weights[rank]
+		}
+	}
+	cards := int(state.cards[1])
+	if cards > unseen {
+		cards = unseen
+	}
+
+	// The opponent has to say go if none of their cards can be played
+	goChance := 1.0
+	if playable > 0 && weightTotal > 0 {
+		goChance = combinations(unseen-playable, cards) / combinations(unseen, cards)
+	}
+	value := 0.0
+	if goChance > 0 {
+		goState := state
+		goState.gone[1] = true
+		goState.turn = 0
+		value += goChance * search.value(goState)
+	}
+	if goChance == 1 {
+		return value
+	}
+	for rank, count := range state.hands[1] {
+		if count == 0 || int(state.total)+values[rank] > 31 {
+			continue
+		}
+		chance := float64(count) * search.weights[rank] / weightTotal
+		value += (1 - goChance) * chance * search.play(state, rank)
+	}
+	return value
+}
+
+// play puts a rank into the count and returns the value of the resulting position
+func (search *pegSearch) play(state searchState, rank int) float64 {
+	player := state.turn
+	state.hands[player][rank]--
+	state.cards[player]--
+	if state.cards[player] < 0 {
+		state.cards[player] = 0
+	}
+	if int(state.length) < len(state.count) {
+		state.count[state.length] = int8(rank)
+		state.length++
+	}
+	state.total += int8(values[rank])
+	state.last = player
+
+	points := float64(search.countPoints(state))
+	if player == 1 {
+		points = -points
+	}
+	if state.total == 31 {
+		return points + search.value(state.reset())
+	}
+	state.turn = 1 - player
+	return points + search.value(state)
+}
+
+// endCount scores the go when neither player can continue and starts a new count
+func (search *pegSearch) endCount(state searchState) float64 {
+	points := 0.0
+	if state.length > 0 && state.total != 31 {
+		points = state.lastSign()
+	}
+	return points + search.value(state.reset())
+}
+
+// countPoints returns the points scored by the last card of the count
+func (search *pegSearch) countPoints(state searchState) int {
+	key := countKey{count: state.count, length: state.length}
+	if points, ok := search.points[key]; ok {
+		return points
+	}
+	field := Hand{}
+	for ii, rank := range state.count[:state.length] {
+		field = append(field, Card{Name: names[rank], Value: values[rank], Order: int(rank), Suit: suits[ii%len(suits)]})
+	}
+	points := 0
+	for _, score := range field.FieldScore() {
+		points += score.Value
+	}
+	search.points[key] = points
+	return points
+}
+
+// reset starts a new count, led by the player after the last to play
+func (state searchState) reset() searchState {
+	state.count = [12]int8{}
+	state.length = 0
+	state.total = 0
+	state.gone = [2]bool{}
+	state.turn = 1 - state.last
+	return state
+}
+
+// lastSign returns 1 if the searching player played last, otherwise -1
+func (state searchState) lastSign() float64 {
+	if state.last == 0 {
+		return 1
+	}
+	return -1
+}
+
+// combinations returns n choose k as a float
+func combinations(n int, k int) float64 {
+	if k < 0 || k > n {
+		return 0
+	}
+	result := 1.0
+	for ii := 0; ii < k; ii++ {
+		result = result * float64(n-ii) / float64(ii+1)
+	}
+	return result
+}
+
+// SearchStrategy pegs with GetSearchPlays in two player games, weighting the
+// opponent's cards with a HandModel of the round so far, and uses the
+// default strategy for discards, for games with more players and for
+// games pegging to a limit other than 31, counting to it only once, as in
+// five-card, or played for lowball. Once the
+// opponent's cards are determined, such as when every unseen card has to be
+// in their hand, the search is exact
+type SearchStrategy struct {
+	DefaultStrategy
+}
+
+// ChoosePlay picks the best searched play
+func (strategy SearchStrategy) ChoosePlay(view PlayerView) (card Card, cantPlay bool) {
+	if len(view.Players) != 2 || view.rules().PegLimit != SixCardRules.PegLimit ||
+		view.rules().SingleCount || view.rules().Lowball {
+		return strategy.DefaultStrategy.ChoosePlay(view)
+	}
+	model := view.HandModel()
+	opponent := PeggingOpponent{
+		Cards:  view.NextPlayer().Cards,
+		Gone:   view.NextPlayer().Gone,
+		Unseen: view.Unseen(),
+	}
+	if hand, known := model.knownHand(opponent.Unseen); known {
+		opponent.Hand = hand
+	}
+	plays, cantPlay := view.Hand.searchPlays(view.Field, opponent, model.RankWeights())
+	if cantPlay {
+		return
+	}
+	card = plays[0].Card
+	return
+}
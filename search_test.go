@@ -0,0 +1,110 @@
+package poner_test
+
+import (
+	"testing"
+
+	"github.com/blakecallens/poner"
+)
+
+func TestGetSearchPlaysKnown(t *testing.T) {
+	deck := poner.Deck{}.New()
+	field, err := deck.PullCards("Kc Ac")
+	if err != nil {
+		t.Errorf("Error pulling cards from deck: %v", err)
+		return
+	}
+	hand, err := deck.PullCards("10s 5s")
+	if err != nil {
+		t.Errorf("Error pulling cards from deck: %v", err)
+		return
+	}
+	opponentHand, err := deck.PullCards("5h")
+	if err != nil {
+		t.Errorf("Error pulling cards from deck: %v", err)
+		return
+	}
+	// Leading the 10 lets the 5 pair the opponent's 5 for 31, leading the 5 gives up the pair
+	plays, cantPlay := hand.GetSearchPlays(field, poner.PeggingOpponent{Hand: opponentHand, Cards: 1})
+	if cantPlay || len(plays) != 2 {
+		t.Errorf("Error searching plays, got %v plays, want 2", len(plays))
+		return
+	}
+	if plays[0].Card.Value != 10 || plays[0].Expected != 4 {
+		t.Errorf("Error searching plays, got %v for %v, want 10♠ for 4", plays[0].Card, plays[0].Expected)
+	}
+	if plays[1].Expected != 0 {
+		t.Errorf("Error searching plays, got %v for %v, want 0", plays[1].Card, plays[1].Expected)
+	}
+}
+
+func TestGetSearchPlaysUnknown(t *testing.T) {
+	deck := poner.Deck{}.New()
+	field, err := deck.PullCards("Kc")
+	if err != nil {
+		t.Errorf("Error pulling cards from deck: %v", err)
+		return
+	}
+	hand, err := deck.PullCards("5s 3d Qd 8h")
+	if err != nil {
+		t.Errorf("Error pulling cards from deck: %v", err)
+		return
+	}
+	opponent := poner.PeggingOpponent{Cards: 3, Unseen: poner.Hand(deck.Cards)}
+	bestCard, cantPlay := hand.GetBestSearchPlay(field, opponent)
+	if cantPlay {
+		t.Error("Error searching plays, got cantPlay, want canPlay")
+	}
+	if bestCard.Value != 5 {
+		t.Errorf("Error searching plays, got %v, want 5♠", bestCard)
+	}
+
+	field, err = deck.PullCards("Qs Js Ks")
+	if err != nil {
+		t.Errorf("Error pulling cards from deck: %v", err)
+		return
+	}
+	_, cantPlay = hand[2:].GetBestSearchPlay(field, opponent)
+	if !cantPlay {
+		t.Error("Error searching plays, got canPlay, want cantPlay")
+	}
+}
+
+func TestSearchStrategy(t *testing.T) {
+	players := []poner.Player{
+		{Name: "Bob", IsComputer: true, SkillLevel: 4, Strategy: poner.SearchStrategy{}},
+		{Name: "Sue", IsComputer: true, SkillLevel: 4},
+	}
+	game := poner.Game{}
+	game.New(players)
+	playUntilOver(t, &game)
+}
+
+func TestSearchStrategyKnown(t *testing.T) {
+	deck := poner.Deck{}.New()
+	hand, err := deck.PullCards("7s 8d 4c")
+	if err != nil {
+		t.Errorf("Error pulling cards from deck: %v", err)
+		return
+	}
+	opponentHand, err := deck.PullCards("7h 6c 9d")
+	if err != nil {
+		t.Errorf("Error pulling cards from deck: %v", err)
+		return
+	}
+	// Every other card has been seen, so the opponent must hold the last three
+	view := poner.PlayerView{
+		Hand:    hand,
+		Dealt:   append(poner.Hand(deck.Cards), hand...),
+		Dealer:  1,
+		Players: []poner.PublicPlayer{{Cards: 3}, {Cards: 3}},
+	}
+	want, _ := hand.GetBestSearchPlay(poner.Hand{}, poner.PeggingOpponent{Hand: opponentHand, Cards: 3})
+	card, cantPlay := poner.SearchStrategy{}.ChoosePlay(view)
+	if cantPlay || card != want {
+		t.Errorf("Error choosing play, got %v, want %v from the exact search", card, want)
+	}
+	guessed, _ := hand.GetBestSearchPlay(poner.Hand{}, poner.PeggingOpponent{Unseen: opponentHand, Cards: 3})
+	if guessed == want {
+		t.Errorf("Error choosing play, exact and expected searches both chose %v", want)
+	}
+}
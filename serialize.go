@@ -0,0 +1,844 @@
+package poner
+
+import (
+	"encoding/binary"
+	"encoding/json"
+	"fmt"
+	"math"
+	"strings"
+)
+
+// SnapshotVersion is the version of the JSON and binary game encodings. Random
+// sources and strategies aren't encoded, so a resumed game gets new ones
+// unless they are set after decoding
+const SnapshotVersion = 1
+
+// Code returns the card as a short code, such as 5H or 10S
+func (card Card) Code() string {
+	index := card.index()
+	if index < 0 {
+		return ""
+	}
+	return names[index%13] + suitAlts[index/13]
+}
+
+// ParseCard parses a card code, such as 5H or 10s
+func ParseCard(code string) (card Card, err error) {
+	size := len(code)
+	if size != 2 && size != 3 {
+		err = fmt.Errorf("ParseCard:: invalid card %v", code)
+		return
+	}
+	name, suit := strings.ToUpper(code[:size-1]), strings.ToUpper(code[size-1:])
+	for suitIndex, alt := range suitAlts {
+		if alt != suit {
+			continue
+		}
+		for order := range names {
+			if names[order] == name {
+				card = cardFromIndex(suitIndex*13 + order)
+				return
+			}
+		}
+	}
+	err = fmt.Errorf("ParseCard:: invalid card %v", code)
+	return
+}
+
+// index returns the card's position in a new deck, or -1 if it isn't a valid card
+func (card Card) index() int {
+	if card.Order < 0 || card.Order >= len(names) || card.Name != names[card.Order] || card.Value != values[card.Order] {
+		return -1
+	}
+	for suitIndex, suit := range suits {
+		if card.Suit == suit {
+			return suitIndex*13 + card.Order
+		}
+	}
+	return -1
+}
+
+// cardFromIndex returns the card at a position in a new deck
+func cardFromIndex(index int) Card {
+	order := index % 13
+	return Card{Name: names[order], Value: values[order], Order: order, Suit: suits[index/13]}
+}
+
+// MarshalJSON encodes the card as its code, or null for no card
+func (card Card) MarshalJSON() ([]byte, error) {
+	if card == (Card{}) {
+		return []byte("null"), nil
+	}
+	code := card.Code()
+	if code == "" {
+		return nil, fmt.Errorf("MarshalJSON:: invalid card %v", card)
+	}
+	return json.Marshal(code)
+}
+
+// UnmarshalJSON decodes a card from its code
+func (card *Card) UnmarshalJSON(data []byte) (err error) {
+	var code *string
+	err = json.Unmarshal(data, &code)
+	if err != nil {
+		return
+	}
+	if code == nil {
+		*card = Card{}
+		return
+	}
+	*card, err = ParseCard(*code)
+	return
+}
+
+// MarshalJSON encodes the hand as a list of card codes
+func (hand Hand) MarshalJSON() ([]byte, error) {
+	return json.Marshal([]Card(append(Hand{}, hand...)))
+}
+
+// UnmarshalJSON decodes a hand from a list of card codes
+func (hand *Hand) UnmarshalJSON(data []byte) (err error) {
+	cards := []Card{}
+	err = json.Unmarshal(data, &cards)
+	if err != nil {
+		return
+	}
+	*hand = Hand(cards)
+	return
+}
+
+// deckJSON is the JSON encoding of a deck
+type deckJSON struct {
+	Cards Hand `json:"cards"`
+}
+
+// MarshalJSON encodes the cards left in the deck
+func (deck Deck) MarshalJSON() ([]byte, error) {
+	return json.Marshal(deckJSON{Cards: deck.Cards})
+}
+
+// UnmarshalJSON decodes the cards left in the deck, keeping its random source
+func (deck *Deck) UnmarshalJSON(data []byte) (err error) {
+	decoded := deckJSON{}
+	err = json.Unmarshal(data, &decoded)
+	if err != nil {
+		return
+	}
+	deck.Cards = decoded.Cards
+	deck.GetFrequencies()
+	return
+}
+
+// discardJSON is the JSON encoding of a discard
+type discardJSON struct {
+	Held             Hand    `json:"held"`
+	Discarded        Hand    `json:"discarded"`
+	Played           Hand    `json:"played"`
+	HeldAverage      float32 `json:"heldAverage"`
+	DiscardedAverage float32 `json:"discardedAverage"`
+}
+
+// MarshalJSON encodes the discard
+func (discard Discard) MarshalJSON() ([]byte, error) {
+	return json.Marshal(discardJSON(discard))
+}
+
+// UnmarshalJSON decodes the discard
+func (discard *Discard) UnmarshalJSON(data []byte) (err error) {
+	decoded := discardJSON{}
+	err = json.Unmarshal(data, &decoded)
+	if err != nil {
+		return
+	}
+	*discard = Discard(decoded)
+	return
+}
+
+// playerJSON is the JSON encoding of a player
+type playerJSON struct {
+	Name        string  `json:"name"`
+	Score       int     `json:"score"`
+	LastScore   int     `json:"lastScore"`
+	GamesWon    int     `json:"gamesWon"`
+	DealtHand   Hand    `json:"dealtHand"`
+	PlayingHand Hand    `json:"playingHand"`
+	Discard     Discard `json:"discard"`
+	Gone        bool    `json:"gone"`
+	IsComputer  bool    `json:"isComputer"`
+	SkillLevel  int     `json:"skillLevel"`
+}
+
+// MarshalJSON encodes the player, without their random source or strategy
+func (player Player) MarshalJSON() ([]byte, error) {
+	return json.Marshal(playerJSON{
+		Name:        player.Name,
+		Score:       player.Score,
+		LastScore:   player.LastScore,
+		GamesWon:    player.GamesWon,
+		DealtHand:   player.DealtHand,
+		PlayingHand: player.PlayingHand,
+		Discard:     player.Discard,
+		Gone:        player.Gone,
+		IsComputer:  player.IsComputer,
+		SkillLevel:  player.SkillLevel,
+	})
+}
+
+// UnmarshalJSON decodes the player, keeping their random source and strategy
+func (player *Player) UnmarshalJSON(data []byte) (err error) {
+	decoded := playerJSON{}
+	err = json.Unmarshal(data, &decoded)
+	if err != nil {
+		return
+	}
+	player.Name = decoded.Name
+	player.Score = decoded.Score
+	player.LastScore = decoded.LastScore
+	player.GamesWon = decoded.GamesWon
+	player.DealtHand = decoded.DealtHand
+	player.PlayingHand = decoded.PlayingHand
+	player.Discard = decoded.Discard
+	player.Gone = decoded.Gone
+	player.IsComputer = decoded.IsComputer
+	player.SkillLevel = decoded.SkillLevel
+	return
+}
+
+// gameJSON is the JSON encoding of a game, with the winner as a player index
+type gameJSON struct {
+	Version        int          `json:"version"`
+	Players        []Player     `json:"players"`
+	Round          int          `json:"round"`
+	ToWin          int          `json:"toWin"`
+	Dealer         int          `json:"dealer"`
+	ActivePlayer   int          `json:"activePlayer"`
+	LastPlayer     int          `json:"lastPlayer"`
+	Phase          Phase        `json:"phase"`
+	Deck           Deck         `json:"deck"`
+	Starter        Card         `json:"starter"`
+	Field          Hand         `json:"field"`
+	Crib           Hand         `json:"crib"`
+	Winner         int          `json:"winner"`
+	FairDeal       bool         `json:"fairDeal"`
+	DealCommitment string       `json:"dealCommitment,omitempty"`
+	NextSeed       string       `json:"nextSeed,omitempty"`
+	DealSeed       string       `json:"dealSeed,omitempty"`
+	Teams          bool         `json:"teams"`
+	Rules          Rules        `json:"rules"`
+	Muggins        bool         `json:"muggins"`
+	OverClaim      OverClaim    `json:"overClaim"`
+	Missed         *MissedScore `json:"missed,omitempty"`
+	Events         []Event      `json:"events"`
+}
+
+// MarshalJSON encodes a snapshot of the game
+func (game Game) MarshalJSON() ([]byte, error) {
+	encoded := gameJSON{
+		Version:        SnapshotVersion,
+		Players:        game.Players,
+		Round:          game.Round,
+		ToWin:          game.ToWin,
+		Dealer:         game.Dealer,
+		ActivePlayer:   game.ActivePlayer,
+		LastPlayer:     game.LastPlayer,
+		Phase:          game.Phase,
+		Deck:           game.Deck,
+		Starter:        game.Starter,
+		Field:          game.Field,
+		Crib:           game.Crib,
+		Winner:         game.playerIndex(game.Winner),
+		FairDeal:       game.FairDeal,
+		DealCommitment: game.DealCommitment,
+		Teams:          game.Teams,
+		Rules:          game.Rules,
+		Muggins:        game.Muggins,
+		OverClaim:      game.OverClaim,
+		Missed:         game.Missed,
+		Events:         game.Events,
+	}
+	if game.nextSeed != nil {
+		encoded.NextSeed = game.nextSeed.String()
+	}
+	if game.dealSeed != nil {
+		encoded.DealSeed = game.dealSeed.String()
+	}
+	return json.Marshal(encoded)
+}
+
+// UnmarshalJSON decodes a snapshot of the game, keeping its random source
+func (game *Game) UnmarshalJSON(data []byte) (err error) {
+	decoded := gameJSON{}
+	err = json.Unmarshal(data, &decoded)
+	if err != nil {
+		return
+	}
+	err = checkSnapshotVersion(decoded.Version)
+	if err != nil {
+		err = fmt.Errorf("UnmarshalJSON:: %v", err)
+		return
+	}
+	nextSeed, err := parseOptionalSeed(decoded.NextSeed)
+	if err != nil {
+		return
+	}
+	dealSeed, err := parseOptionalSeed(decoded.DealSeed)
+	if err != nil {
+		return
+	}
+
+	decodedGame := Game{
+		Players:      decoded.Players,
+		Dealer:       decoded.Dealer,
+		ActivePlayer: decoded.ActivePlayer,
+		LastPlayer:   decoded.LastPlayer,
+		Phase:        decoded.Phase,
+		Missed:       decoded.Missed,
+	}
+	err = decodedGame.checkSnapshot(decoded.Winner)
+	if err != nil {
+		err = fmt.Errorf("UnmarshalJSON:: %v", err)
+		return
+	}
+
+	game.Players = decoded.Players
+	game.Round = decoded.Round
+	game.ToWin = decoded.ToWin
+	game.Dealer = decoded.Dealer
+	game.ActivePlayer = decoded.ActivePlayer
+	game.LastPlayer = decoded.LastPlayer
+	game.Phase = decoded.Phase
+	game.Deck = Deck{Cards: decoded.Deck.Cards, Random: game.Random}
+	game.Deck.GetFrequencies()
+	game.Starter = decoded.Starter
+	game.Field = decoded.Field
+	game.Crib = decoded.Crib
+	game.FairDeal = decoded.FairDeal
+	game.DealCommitment = decoded.DealCommitment
+	game.nextSeed, game.dealSeed = nextSeed, dealSeed
+	game.Teams = decoded.Teams
+	game.Rules = decoded.Rules
+	game.Muggins = decoded.Muggins
+	game.OverClaim = decoded.OverClaim
+	game.Missed = decoded.Missed
+	game.Events = decoded.Events
+	game.restore(decoded.Winner)
+	return
+}
+
+// restore points the winner at its player and seeds the players' random sources from the game's
+func (game *Game) restore(winner int) {
+	game.Winner = nil
+	if winner >= 0 {
+		game.Winner = &game.Players[winner]
+	}
+	if game.Random == nil {
+		return
+	}
+	game.seedPlayers()
+}
+
+// checkSnapshotVersion returns an error for snapshots this version can't decode
+func checkSnapshotVersion(version int) error {
+	if version != SnapshotVersion {
+		return fmt.Errorf("snapshot version %v is not supported, want %v", version, SnapshotVersion)
+	}
+	return nil
+}
+
+// checkSnapshot returns an error for a decoded winner, player index or phase
+// that is outside the game
+func (game *Game) checkSnapshot(winner int) error {
+	if winner < -1 || winner >= len(game.Players) {
+		return fmt.Errorf("invalid winner %v", winner)
+	}
+	if game.Phase < 0 || int(game.Phase) >= len(phaseNames) {
+		return fmt.Errorf("invalid phase %v", int(game.Phase))
+	}
+	names := []string{"dealer", "active player", "last player"}
+	indexes := []int{game.Dealer, game.ActivePlayer, game.LastPlayer}
+	if game.Missed != nil {
+		names, indexes = append(names, "missed player"), append(indexes, game.Missed.Player)
+	}
+	for ii, index := range indexes {
+		// A game that hasn't been started has no players, and every index is 0
+		if index != 0 && (index < 0 || index >= len(game.Players)) {
+			return fmt.Errorf("invalid %v %v", names[ii], index)
+		}
+	}
+	return nil
+}
+
+// parseOptionalSeed parses a hex encoded shuffle seed, or returns nil for an empty string
+func parseOptionalSeed(seedString string) (seed *ShuffleSeed, err error) {
+	if seedString == "" {
+		return
+	}
+	parsed, err := ParseShuffleSeed(seedString)
+	if err != nil {
+		return
+	}
+	seed = &parsed
+	return
+}
+
+// MarshalText encodes the phase as its name
+func (phase Phase) MarshalText() ([]byte, error) {
+	if phase < 0 || int(phase) >= len(phaseNames) {
+		return nil, fmt.Errorf("MarshalText:: invalid phase %v", int(phase))
+	}
+	return []byte(phaseNames[phase]), nil
+}
+
+// UnmarshalText decodes the phase from its name
+func (phase *Phase) UnmarshalText(text []byte) error {
+	for index, name := range phaseNames {
+		if name == string(text) {
+			*phase = Phase(index)
+			return nil
+		}
+	}
+	return fmt.Errorf("UnmarshalText:: invalid phase %v", string(text))
+}
+
+// MarshalText encodes the over claim rule as its name
+func (overClaim OverClaim) MarshalText() ([]byte, error) {
+	if overClaim < 0 || int(overClaim) >= len(overClaimNames) {
+		return nil, fmt.Errorf("MarshalText:: invalid over claim %v", int(overClaim))
+	}
+	return []byte(overClaimNames[overClaim]), nil
+}
+
+// UnmarshalText decodes the over claim rule from its name
+func (overClaim *OverClaim) UnmarshalText(text []byte) error {
+	for index, name := range overClaimNames {
+		if name == string(text) {
+			*overClaim = OverClaim(index)
+			return nil
+		}
+	}
+	return fmt.Errorf("UnmarshalText:: invalid over claim %v", string(text))
+}
+
+// MarshalText encodes the event type as its name
+func (eventType EventType) MarshalText() ([]byte, error) {
+	if eventType < 0 || int(eventType) >= len(eventNames) {
+		return nil, fmt.Errorf("MarshalText:: invalid event type %v", int(eventType))
+	}
+	return []byte(eventNames[eventType]), nil
+}
+
+// UnmarshalText decodes the event type from its name
+func (eventType *EventType) UnmarshalText(text []byte) error {
+	for index, name := range eventNames {
+		if name == string(text) {
+			*eventType = EventType(index)
+			return nil
+		}
+	}
+	return fmt.Errorf("UnmarshalText:: invalid event type %v", string(text))
+}
+
+// binaryMagic starts every binary game snapshot
+const binaryMagic = "PONR"
+
+// MarshalBinary encodes a compact snapshot of the game. Cards are a single
+// byte and numbers are varints
+func (game Game) MarshalBinary() ([]byte, error) {
+	encoder := binaryEncoder{data: []byte(binaryMagic)}
+	encoder.uvarint(SnapshotVersion)
+	encoder.players(game.Players)
+	encoder.varint(game.Round)
+	encoder.varint(game.ToWin)
+	encoder.varint(game.Dealer)
+	encoder.varint(game.ActivePlayer)
+	encoder.varint(game.LastPlayer)
+	encoder.varint(int(game.Phase))
+	encoder.hand(game.Deck.Cards)
+	encoder.card(game.Starter)
+	encoder.hand(game.Field)
+	encoder.hand(game.Crib)
+	encoder.varint(game.playerIndex(game.Winner))
+	encoder.bool(game.FairDeal)
+	encoder.string(game.DealCommitment)
+	encoder.seed(game.nextSeed)
+	encoder.seed(game.dealSeed)
+	encoder.bool(game.Muggins)
+	encoder.varint(int(game.OverClaim))
+	encoder.bool(game.Missed != nil)
+	if game.Missed != nil {
+		encoder.varint(game.Missed.Player)
+		encoder.varint(game.Missed.Points)
+		encoder.scores(game.Missed.Scores)
+	}
+	encoder.bool(game.Teams)
+	encoder.rules(game.Rules)
+	encoder.uvarint(len(game.Events))
+	for _, event := range game.Events {
+		encoder.event(event)
+	}
+	if encoder.err != nil {
+		return nil, fmt.Errorf("MarshalBinary:: %v", encoder.err)
+	}
+	return encoder.data, nil
+}
+
+// UnmarshalBinary decodes a binary snapshot of the game, keeping its random source
+func (game *Game) UnmarshalBinary(data []byte) (err error) {
+	if !strings.HasPrefix(string(data), binaryMagic) {
+		return fmt.Errorf("UnmarshalBinary:: not a game snapshot")
+	}
+	decoder := binaryDecoder{data: data[len(binaryMagic):]}
+	version := decoder.uvarint()
+	if decoder.err == nil {
+		err = checkSnapshotVersion(version)
+		if err != nil {
+			return fmt.Errorf("UnmarshalBinary:: %v", err)
+		}
+	}
+	decoded := Game{Random: game.Random}
+	decoded.Players = decoder.players()
+	decoded.Round = decoder.varint()
+	decoded.ToWin = decoder.varint()
+	decoded.Dealer = decoder.varint()
+	decoded.ActivePlayer = decoder.varint()
+	decoded.LastPlayer = decoder.varint()
+	decoded.Phase = Phase(decoder.varint())
+	decoded.Deck = Deck{Cards: decoder.hand(), Random: game.Random}
+	decoded.Deck.GetFrequencies()
+	decoded.Starter = decoder.card()
+	decoded.Field = decoder.hand()
+	decoded.Crib = decoder.hand()
+	winner := decoder.varint()
+	decoded.FairDeal = decoder.bool()
+	decoded.DealCommitment = decoder.string()
+	decoded.nextSeed = decoder.seed()
+	decoded.dealSeed = decoder.seed()
+	decoded.Muggins = decoder.bool()
+	decoded.OverClaim = OverClaim(decoder.varint())
+	if decoder.bool() {
+		decoded.Missed = &MissedScore{Player: decoder.varint(), Points: decoder.varint(), Scores: decoder.scores()}
+	}
+	decoded.Teams = decoder.bool()
+	decoded.Rules = decoder.rules()
+	decoded.Events = make([]Event, decoder.count())
+	for ii := range decoded.Events {
+		decoded.Events[ii] = decoder.event()
+	}
+	if decoder.err == nil && len(decoder.data) > 0 {
+		decoder.err = fmt.Errorf("%v unexpected bytes", len(decoder.data))
+	}
+	if decoder.err == nil {
+		decoder.err = decoded.checkSnapshot(winner)
+	}
+	if decoder.err != nil {
+		return fmt.Errorf("UnmarshalBinary:: %v", decoder.err)
+	}
+
+	*game = decoded
+	game.restore(winner)
+	return
+}
+
+// binaryEncoder appends values to a binary snapshot, keeping the first error
+type binaryEncoder struct {
+	data []byte
+	err  error
+}
+
+func (encoder *binaryEncoder) uvarint(value int) {
+	buffer := [binary.MaxVarintLen64]byte{}
+	size := binary.PutUvarint(buffer[:], uint64(value))
+	encoder.data = append(encoder.data, buffer[:size]...)
+}
+
+func (encoder *binaryEncoder) varint(value int) {
+	buffer := [binary.MaxVarintLen64]byte{}
+	size := binary.PutVarint(buffer[:], int64(value))
+	encoder.data = append(encoder.data, buffer[:size]...)
+}
+
+func (encoder *binaryEncoder) bool(value bool) {
+	if value {
+		encoder.data = append(encoder.data, 1)
+		return
+	}
+	encoder.data = append(encoder.data, 0)
+}
+
+func (encoder *binaryEncoder) string(value string) {
+	encoder.uvarint(len(value))
+	encoder.data = append(encoder.data, value...)
+}
+
+func (encoder *binaryEncoder) float(value float32) {
+	buffer := [4]byte{}
+	binary.LittleEndian.PutUint32(buffer[:], math.Float32bits(value))
+	encoder.data = append(encoder.data, buffer[:]...)
+}
+
+// card encodes a card as its position in a new deck plus one, or zero for no card
+func (encoder *binaryEncoder) card(card Card) {
+	if card == (Card{}) {
+		encoder.data = append(encoder.data, 0)
+		return
+	}
+	index := card.index()
+	if index < 0 && encoder.err == nil {
+		encoder.err = fmt.Errorf("invalid card %v", card)
+	}
+	encoder.data = append(encoder.data, byte(index+1))
+}
+
+func (encoder *binaryEncoder) hand(hand Hand) {
+	encoder.uvarint(len(hand))
+	for _, card := range hand {
+		encoder.card(card)
+	}
+}
+
+func (encoder *binaryEncoder) scores(scores []Score) {
+	encoder.uvarint(len(scores))
+	for _, score := range scores {
+		encoder.string(score.Name)
+		encoder.varint(score.Value)
+		encoder.hand(score.Pairing)
+	}
+}
+
+func (encoder *binaryEncoder) players(players []Player) {
+	encoder.uvarint(len(players))
+	for _, player := range players {
+		encoder.string(player.Name)
+		encoder.varint(player.Score)
+		encoder.varint(player.LastScore)
+		encoder.varint(player.GamesWon)
+		encoder.hand(player.DealtHand)
+		encoder.hand(player.PlayingHand)
+		encoder.hand(player.Discard.Held)
+		encoder.hand(player.Discard.Discarded)
+		encoder.hand(player.Discard.Played)
+		encoder.float(player.Discard.HeldAverage)
+		encoder.float(player.Discard.DiscardedAverage)
+		encoder.bool(player.Gone)
+		encoder.bool(player.IsComputer)
+		encoder.varint(player.SkillLevel)
+	}
+}
+
+func (encoder *binaryEncoder) seed(seed *ShuffleSeed) {
+	encoder.bool(seed != nil)
+	if seed != nil {
+		encoder.data = append(encoder.data, seed[:]...)
+	}
+}
+
+func (encoder *binaryEncoder) event(event Event) {
+	encoder.varint(int(event.Type))
+	encoder.varint(event.Round)
+	encoder.varint(event.Player)
+	encoder.card(event.Card)
+	encoder.hand(event.Cards)
+	encoder.uvarint(len(event.Hands))
+	for _, hand := range event.Hands {
+		encoder.hand(hand)
+	}
+	encoder.scores(event.Scores)
+	encoder.varint(event.Total)
+	encoder.players(event.Players)
+	encoder.varint(event.ToWin)
+	encoder.varint(event.Dealer)
+	encoder.bool(event.Muggins)
+	encoder.varint(int(event.OverClaim))
+	encoder.bool(event.Teams)
+	encoder.bool(event.Rules != nil)
+	if event.Rules != nil {
+		encoder.rules(*event.Rules)
+	}
+}
+
+func (encoder *binaryEncoder) rules(rules Rules) {
+	encoder.string(rules.Name)
+	encoder.varint(rules.HandSize)
+	encoder.varint(rules.CribSize)
+	encoder.varint(rules.PegLimit)
+	encoder.varint(rules.ToWin)
+	encoder.bool(rules.SingleCount)
+	encoder.varint(rules.PoneStart)
+	encoder.bool(rules.CribFlushStarter)
+	encoder.bool(rules.HandFlushStarter)
+	encoder.varint(rules.Nobs)
+	encoder.varint(rules.HisHeels)
+	encoder.varint(rules.Nineteen)
+	encoder.bool(rules.Lowball)
+}
+
+// binaryDecoder reads values from a binary snapshot, keeping the first error.
+// Once an error is found every read returns a zero value
+type binaryDecoder struct {
+	data []byte
+	err  error
+}
+
+func (decoder *binaryDecoder) fail(format string, args ...interface{}) {
+	if decoder.err == nil {
+		decoder.err = fmt.Errorf(format, args...)
+	}
+	decoder.data = nil
+}
+
+func (decoder *binaryDecoder) bytes(size int) []byte {
+	if size > len(decoder.data) {
+		decoder.fail("unexpected end of snapshot")
+		return make([]byte, size)
+	}
+	read := decoder.data[:size]
+	decoder.data = decoder.data[size:]
+	return read
+}
+
+func (decoder *binaryDecoder) uvarint() int {
+	value, size := binary.Uvarint(decoder.data)
+	if size <= 0 || value > math.MaxInt32 {
+		decoder.fail("invalid number in snapshot")
+		return 0
+	}
+	decoder.data = decoder.data[size:]
+	return int(value)
+}
+
+func (decoder *binaryDecoder) varint() int {
+	value, size := binary.Varint(decoder.data)
+	if size <= 0 || value > math.MaxInt32 || value < math.MinInt32 {
+		decoder.fail("invalid number in snapshot")
+		return 0
+	}
+	decoder.data = decoder.data[size:]
+	return int(value)
+}
+
+// count reads a length, which can't be more than the bytes left in the snapshot
+func (decoder *binaryDecoder) count() int {
+	count := decoder.uvarint()
+	if count > len(decoder.data) {
+		decoder.fail("invalid length %v in snapshot", count)
+		return 0
+	}
+	return count
+}
+
+func (decoder *binaryDecoder) bool() bool {
+	value := decoder.bytes(1)[0]
+	if value > 1 {
+		decoder.fail("invalid bool %v in snapshot", value)
+	}
+	return value == 1
+}
+
+func (decoder *binaryDecoder) string() string {
+	return string(decoder.bytes(decoder.count()))
+}
+
+func (decoder *binaryDecoder) float() float32 {
+	return math.Float32frombits(binary.LittleEndian.Uint32(decoder.bytes(4)))
+}
+
+func (decoder *binaryDecoder) card() Card {
+	value := int(decoder.bytes(1)[0])
+	if value == 0 {
+		return Card{}
+	}
+	if value > 52 {
+		decoder.fail("invalid card %v in snapshot", value)
+		return Card{}
+	}
+	return cardFromIndex(value - 1)
+}
+
+func (decoder *binaryDecoder) hand() Hand {
+	hand := make(Hand, decoder.count())
+	for ii := range hand {
+		hand[ii] = decoder.card()
+	}
+	return hand
+}
+
+func (decoder *binaryDecoder) scores() []Score {
+	scores := make([]Score, decoder.count())
+	for ii := range scores {
+		scores[ii] = Score{Name: decoder.string(), Value: decoder.varint(), Pairing: decoder.hand()}
+	}
+	return scores
+}
+
+func (decoder *binaryDecoder) players() []Player {
+	players := make([]Player, decoder.count())
+	for ii := range players {
+		player := &players[ii]
+		player.Name = decoder.string()
+		player.Score = decoder.varint()
+		player.LastScore = decoder.varint()
+		player.GamesWon = decoder.varint()
+		player.DealtHand = decoder.hand()
+		player.PlayingHand = decoder.hand()
+		player.Discard.Held = decoder.hand()
+		player.Discard.Discarded = decoder.hand()
+		player.Discard.Played = decoder.hand()
+		player.Discard.HeldAverage = decoder.float()
+		player.Discard.DiscardedAverage = decoder.float()
+		player.Gone = decoder.bool()
+		player.IsComputer = decoder.bool()
+		player.SkillLevel = decoder.varint()
+	}
+	return players
+}
+
+func (decoder *binaryDecoder) seed() *ShuffleSeed {
+	if !decoder.bool() {
+		return nil
+	}
+	seed := ShuffleSeed{}
+	copy(seed[:], decoder.bytes(len(seed)))
+	return &seed
+}
+
+func (decoder *binaryDecoder) event() (event Event) {
+	event.Type = EventType(decoder.varint())
+	event.Round = decoder.varint()
+	event.Player = decoder.varint()
+	event.Card = decoder.card()
+	event.Cards = decoder.hand()
+	event.Hands = make([]Hand, decoder.count())
+	for ii := range event.Hands {
+		event.Hands[ii] = decoder.hand()
+	}
+	event.Scores = decoder.scores()
+	event.Total = decoder.varint()
+	event.Players = decoder.players()
+	event.ToWin = decoder.varint()
+	event.Dealer = decoder.varint()
+	event.Muggins = decoder.bool()
+	event.OverClaim = OverClaim(decoder.varint())
+	event.Teams = decoder.bool()
+	if decoder.bool() {
+		rules := decoder.rules()
+		event.Rules = &rules
+	}
+	return
+}
+
+func (decoder *binaryDecoder) rules() (rules Rules) {
+	rules.Name = decoder.string()
+	rules.HandSize = decoder.varint()
+	rules.CribSize = decoder.varint()
+	rules.PegLimit = decoder.varint()
+	rules.ToWin = decoder.varint()
+	rules.SingleCount = decoder.bool()
+	rules.PoneStart = decoder.varint()
+	rules.CribFlushStarter = decoder.bool()
+	rules.HandFlushStarter = decoder.bool()
+	rules.Nobs = decoder.varint()
+	rules.HisHeels = decoder.varint()
+	rules.Nineteen = decoder.varint()
+	rules.Lowball = decoder.bool()
+	return
+}
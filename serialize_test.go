@@ -0,0 +1,200 @@
+package poner_test
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"reflect"
+	"strings"
+	"testing"
+
+	"github.com/blakecallens/poner"
+)
+
+func TestCardCode(t *testing.T) {
+	for _, card := range (poner.Deck{}).New().Cards {
+		parsed, err := poner.ParseCard(card.Code())
+		if err != nil {
+			t.Errorf("Error parsing %v: %v", card.Code(), err)
+			return
+		}
+		if parsed != card {
+			t.Errorf("Error parsing %v, got %v, want %v", card.Code(), parsed, card)
+		}
+	}
+	card, err := poner.ParseCard("10s")
+	if err != nil || card.Code() != "10S" {
+		t.Errorf("Error parsing 10s, got %v, %v", card.Code(), err)
+	}
+	for _, code := range []string{"", "1S", "5X", "100S"} {
+		if _, err := poner.ParseCard(code); err == nil {
+			t.Errorf("Error parsing %q, want an error", code)
+		}
+	}
+}
+
+func TestHandJSON(t *testing.T) {
+	deck := poner.Deck{}.New()
+	hand, err := deck.PullCards("5h Js Ad")
+	if err != nil {
+		t.Errorf("Error pulling cards from deck: %v", err)
+		return
+	}
+	encoded, err := json.Marshal(hand)
+	if err != nil {
+		t.Errorf("Error encoding hand: %v", err)
+		return
+	}
+	if string(encoded) != `["5H","JS","AD"]` {
+		t.Errorf("Error encoding hand, got %s", encoded)
+	}
+	decoded := poner.Hand{}
+	err = json.Unmarshal(encoded, &decoded)
+	if err != nil || !reflect.DeepEqual(decoded, hand) {
+		t.Errorf("Error decoding hand, got %v, %v, want %v", decoded, err, hand)
+	}
+}
+
+// pegGame returns a seeded game that is part way through pegging
+func pegGame(t *testing.T) *poner.Game {
+	players := []poner.Player{
+		{Name: "Bob", IsComputer: true, SkillLevel: 4},
+		{Name: "Sue", IsComputer: true, SkillLevel: 4},
+	}
+	game := &poner.Game{}
+	game.SetSeed(5)
+	game.New(players)
+	game.Players[0].Score = 40
+	playUntil(t, game, func(game *poner.Game) bool {
+		return game.Phase == poner.PhasePegging && len(game.Field) >= 2
+	})
+	return game
+}
+
+func TestGameJSON(t *testing.T) {
+	game := pegGame(t)
+	encoded, err := json.Marshal(game)
+	if err != nil {
+		t.Errorf("Error encoding game: %v", err)
+		return
+	}
+	version := fmt.Sprintf(`"version":%v`, poner.SnapshotVersion)
+	if !strings.Contains(string(encoded), version) || !strings.Contains(string(encoded), `"phase":"Pegging"`) {
+		t.Errorf("Error encoding game, got %s", encoded)
+	}
+
+	decoded := poner.Game{}
+	decoded.SetSeed(5)
+	err = json.Unmarshal(encoded, &decoded)
+	if err != nil {
+		t.Errorf("Error decoding game: %v", err)
+		return
+	}
+	reencoded, err := json.Marshal(decoded)
+	if err != nil || !bytes.Equal(encoded, reencoded) {
+		t.Errorf("Error decoding game, got %s, want %s", reencoded, encoded)
+		return
+	}
+	if !reflect.DeepEqual(decoded.Field, game.Field) || decoded.Players[0].Score != game.Players[0].Score {
+		t.Errorf("Error decoding game, got field %v, want %v", decoded.Field, game.Field)
+	}
+	playUntilOver(t, &decoded)
+	if decoded.Winner != &decoded.Players[0] && decoded.Winner != &decoded.Players[1] {
+		t.Error("Error finishing decoded game, the winner isn't one of its players")
+	}
+
+	// The winner is restored by index
+	encoded, err = json.Marshal(decoded)
+	if err != nil {
+		t.Errorf("Error encoding game: %v", err)
+		return
+	}
+	finished := poner.Game{}
+	err = json.Unmarshal(encoded, &finished)
+	if err != nil || finished.Winner == nil || finished.Winner.Name != decoded.Winner.Name ||
+		finished.Winner != &finished.Players[0] && finished.Winner != &finished.Players[1] {
+		t.Errorf("Error decoding winner, got %v, %v", finished.Winner, err)
+	}
+}
+
+func TestGameBinary(t *testing.T) {
+	game := pegGame(t)
+	encoded, err := game.MarshalBinary()
+	if err != nil {
+		t.Errorf("Error encoding game: %v", err)
+		return
+	}
+	jsonEncoded, _ := json.Marshal(game)
+	if len(encoded) >= len(jsonEncoded)/2 {
+		t.Errorf("Error encoding game, got %v bytes, want less than half of %v", len(encoded), len(jsonEncoded))
+	}
+
+	decoded := poner.Game{}
+	decoded.SetSeed(5)
+	err = decoded.UnmarshalBinary(encoded)
+	if err != nil {
+		t.Errorf("Error decoding game: %v", err)
+		return
+	}
+	reencoded, err := decoded.MarshalBinary()
+	if err != nil || !bytes.Equal(encoded, reencoded) {
+		t.Error("Error decoding game, encodings don't match")
+		return
+	}
+	playUntilOver(t, &decoded)
+
+	for size := range encoded {
+		if (&poner.Game{}).UnmarshalBinary(encoded[:size]) == nil {
+			t.Errorf("Error decoding truncated game, want an error at %v bytes", size)
+			return
+		}
+	}
+}
+
+func TestSnapshotIndexes(t *testing.T) {
+	updates := []func(game *poner.Game){
+		func(game *poner.Game) { game.Dealer = 2 },
+		func(game *poner.Game) { game.ActivePlayer = -1 },
+		func(game *poner.Game) { game.LastPlayer = 5 },
+		func(game *poner.Game) { game.Missed = &poner.MissedScore{Player: 3, Points: 2} },
+		func(game *poner.Game) { game.Phase = poner.Phase(9) },
+	}
+	for _, update := range updates {
+		game := pegGame(t)
+		update(game)
+		encoded, err := game.MarshalBinary()
+		if err != nil {
+			t.Errorf("Error encoding game: %v", err)
+			return
+		}
+		err = (&poner.Game{}).UnmarshalBinary(encoded)
+		if err == nil {
+			t.Errorf("Error decoding binary game, did not get err for dealer %v, active %v, last %v, missed %v, phase %v",
+				game.Dealer, game.ActivePlayer, game.LastPlayer, game.Missed, int(game.Phase))
+		}
+		if game.Phase != poner.Phase(9) {
+			encoded, err = json.Marshal(game)
+			if err != nil {
+				t.Errorf("Error encoding game: %v", err)
+				return
+			}
+			err = json.Unmarshal(encoded, &poner.Game{})
+			if err == nil {
+				t.Errorf("Error decoding JSON game, did not get err for dealer %v, active %v, last %v, missed %v",
+					game.Dealer, game.ActivePlayer, game.LastPlayer, game.Missed)
+			}
+		}
+	}
+}
+
+func TestSnapshotVersion(t *testing.T) {
+	game := poner.Game{}
+	err := json.Unmarshal([]byte(`{"version":99,"players":[]}`), &game)
+	if err == nil || !strings.Contains(err.Error(), "version 99") {
+		t.Errorf("Error checking JSON version, got %v", err)
+	}
+	err = game.UnmarshalBinary([]byte("PONR\x63"))
+	if err == nil || !strings.Contains(err.Error(), "version 99") {
+		t.Errorf("Error checking binary version, got %v", err)
+	}
+}
@@ -0,0 +1,147 @@
+package poner
+
+import (
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/binary"
+	"encoding/hex"
+	"errors"
+	"fmt"
+)
+
+// ShuffleSeed is the secret behind a verifiable shuffle. Its commitment is
+// published before the deal and the seed itself is revealed after the hand
+type ShuffleSeed [32]byte
+
+// NewShuffleSeed creates a shuffle seed from the crypto random source
+func NewShuffleSeed() (seed ShuffleSeed, err error) {
+	_, err = rand.Read(seed[:])
+	if err != nil {
+		err = fmt.Errorf("NewShuffleSeed:: %v", err)
+	}
+	return
+}
+
+// ParseShuffleSeed parses a hex encoded shuffle seed
+func ParseShuffleSeed(seedString string) (seed ShuffleSeed, err error) {
+	decoded, err := hex.DecodeString(seedString)
+	if err != nil || len(decoded) != len(seed) {
+		err = fmt.Errorf("ParseShuffleSeed:: invalid seed %v", seedString)
+		return
+	}
+	copy(seed[:], decoded)
+	return
+}
+
+func (seed ShuffleSeed) String() string {
+	return hex.EncodeToString(seed[:])
+}
+
+// Commitment returns the hex encoded SHA-256 hash of the seed
+func (seed ShuffleSeed) Commitment() string {
+	hash := sha256.Sum256(seed[:])
+	return hex.EncodeToString(hash[:])
+}
+
+// seedStream is a SHA-256 counter mode stream of random numbers derived from a seed
+type seedStream struct {
+	seed    ShuffleSeed
+	counter uint64
+}
+
+// Uint64 returns the next 64 random bits from the stream
+func (stream *seedStream) Uint64() uint64 {
+	block := make([]byte, len(stream.seed)+8)
+	copy(block, stream.seed[:])
+	binary.BigEndian.PutUint64(block[len(stream.seed):], stream.counter)
+	stream.counter++
+	hash := sha256.Sum256(block)
+	return binary.BigEndian.Uint64(hash[:8])
+}
+
+// Intn returns an unbiased random number in [0, n)
+func (stream *seedStream) Intn(n int) int {
+	limit := ^uint64(0) - ^uint64(0)%uint64(n)
+	for {
+		value := stream.Uint64()
+		if value < limit {
+			return int(value % uint64(n))
+		}
+	}
+}
+
+// FairShuffle shuffles and cuts the deck with a Fisher–Yates shuffle driven
+// entirely by the seed, so the order can be recomputed once the seed is revealed
+func (deck *Deck) FairShuffle(seed ShuffleSeed) {
+	stream := &seedStream{seed: seed}
+	for ii := len(deck.Cards) - 1; ii > 0; ii-- {
+		jj := stream.Intn(ii + 1)
+		deck.Cards[ii], deck.Cards[jj] = deck.Cards[jj], deck.Cards[ii]
+	}
+	index := stream.Intn(len(deck.Cards))
+	deck.Cards = append(deck.Cards[index:], deck.Cards[:index]...)
+}
+
+// VerifyDeal checks a revealed seed against its commitment and returns the
+// order the deck's cards were in before they were dealt
+func VerifyDeal(commitment string, seed ShuffleSeed) (cards []Card, err error) {
+	if seed.Commitment() != commitment {
+		err = errors.New("VerifyDeal:: seed does not match the commitment")
+		return
+	}
+	deck := Deck{}.New()
+	deck.FairShuffle(seed)
+	cards = deck.Cards
+	return
+}
+
+// CommitDeal picks the seed for the next fair deal and returns its
+// commitment, which can be published to the players before NextRound
+func (game *Game) CommitDeal() (commitment string, err error) {
+	if game.nextSeed == nil {
+		var seed ShuffleSeed
+		seed, err = NewShuffleSeed()
+		if err != nil {
+			return
+		}
+		game.nextSeed = &seed
+	}
+	game.DealCommitment = game.nextSeed.Commitment()
+	commitment = game.DealCommitment
+	return
+}
+
+// RevealDeal returns the seed of the current round's fair deal once the
+// starter has been turned and the pegging is over, since the seed gives away
+// every hand and the starter
+func (game *Game) RevealDeal() (seed ShuffleSeed, err error) {
+	if game.dealSeed == nil {
+		err = errors.New("RevealDeal:: the round was not dealt fairly")
+		return
+	}
+	switch game.Phase {
+	case PhaseShow, PhaseCrib, PhaseDeal, PhaseGameOver:
+	case PhasePegging:
+		if !game.AllPlaysDone() {
+			err = errors.New("RevealDeal:: the hand has not finished")
+			return
+		}
+	default:
+		err = fmt.Errorf("RevealDeal:: the hand has not finished, the game is in %v", game.Phase)
+		return
+	}
+	seed = *game.dealSeed
+	return
+}
+
+// fairShuffle shuffles the game's deck with the committed seed
+func (game *Game) fairShuffle() (err error) {
+	_, err = game.CommitDeal()
+	if err != nil {
+		return
+	}
+	game.Deck.FairShuffle(*game.nextSeed)
+	game.dealSeed = game.nextSeed
+	game.nextSeed = nil
+	return
+}
@@ -0,0 +1,151 @@
+package poner_test
+
+import (
+	"testing"
+
+	"github.com/blakecallens/poner"
+)
+
+func TestShuffleSeed(t *testing.T) {
+	seed, err := poner.NewShuffleSeed()
+	if err != nil {
+		t.Errorf("Error creating shuffle seed: %v", err)
+		return
+	}
+	parsed, err := poner.ParseShuffleSeed(seed.String())
+	if err != nil {
+		t.Errorf("Error parsing shuffle seed: %v", err)
+		return
+	}
+	if parsed != seed {
+		t.Errorf("Error parsing shuffle seed, got %v, want %v", parsed, seed)
+	}
+	_, err = poner.ParseShuffleSeed("not a seed")
+	if err == nil {
+		t.Error("Error parsing shuffle seed, did not get err for bad seed")
+	}
+	if len(seed.Commitment()) != 64 {
+		t.Errorf("Error getting commitment, got %v characters, want 64", len(seed.Commitment()))
+	}
+}
+
+func TestFairShuffle(t *testing.T) {
+	seed, err := poner.NewShuffleSeed()
+	if err != nil {
+		t.Errorf("Error creating shuffle seed: %v", err)
+		return
+	}
+	deck := poner.Deck{}.New()
+	deck.FairShuffle(seed)
+	if len(deck.Cards) != 52 {
+		t.Errorf("Error shuffling deck, got %v cards, want 52", len(deck.Cards))
+	}
+	cards, err := poner.VerifyDeal(seed.Commitment(), seed)
+	if err != nil {
+		t.Errorf("Error verifying deal: %v", err)
+		return
+	}
+	for ii := range cards {
+		if cards[ii] != deck.Cards[ii] {
+			t.Errorf("Error verifying deal, got %v, want %v", cards[ii], deck.Cards[ii])
+			return
+		}
+	}
+	other, err := poner.NewShuffleSeed()
+	if err != nil {
+		t.Errorf("Error creating shuffle seed: %v", err)
+		return
+	}
+	_, err = poner.VerifyDeal(seed.Commitment(), other)
+	if err == nil {
+		t.Error("Error verifying deal, did not get err for mismatched seed")
+	}
+}
+
+func TestFairDeal(t *testing.T) {
+	players := []poner.Player{
+		{Name: "Bob", IsComputer: true, SkillLevel: 4},
+		{Name: "Sue", IsComputer: true, SkillLevel: 4},
+	}
+	game := poner.Game{FairDeal: true}
+	game.New(players)
+	commitment, err := game.CommitDeal()
+	if err != nil {
+		t.Errorf("Error committing deal: %v", err)
+		return
+	}
+	_, err = game.NextRound()
+	if err != nil {
+		t.Errorf("Error starting round: %v", err)
+		return
+	}
+	_, err = game.RevealDeal()
+	if err == nil {
+		t.Error("Error revealing deal, did not get err for unfinished hand")
+	}
+	for ii := range game.Players {
+		game.Players[ii].PlayingHand = poner.Hand{}
+	}
+	seed, err := game.RevealDeal()
+	if err != nil {
+		t.Errorf("Error revealing deal: %v", err)
+		return
+	}
+	cards, err := poner.VerifyDeal(commitment, seed)
+	if err != nil {
+		t.Errorf("Error verifying deal: %v", err)
+		return
+	}
+	for ii, card := range game.Players[0].DealtHand {
+		if cards[ii*2] != card {
+			t.Errorf("Error verifying deal, got %v, want %v", cards[ii*2], card)
+			return
+		}
+	}
+}
+
+func TestRevealDealPhases(t *testing.T) {
+	game := poner.Game{FairDeal: true}
+	game.New([]poner.Player{{Name: "Bob"}, {Name: "Sue"}})
+	commitment, err := game.CommitDeal()
+	if err != nil {
+		t.Errorf("Error committing deal: %v", err)
+		return
+	}
+
+	// The seed gives away every hand and the starter, so it stays hidden
+	// until the pegging is over
+	for game.Phase != poner.PhaseShow {
+		var action poner.Action
+		for index := range game.Players {
+			action, err = humanAction(&game, index)
+			if err == nil {
+				break
+			}
+		}
+		if err != nil {
+			t.Errorf("Error getting action in %v: %v", game.Phase, err)
+			return
+		}
+		_, err = game.Apply(action)
+		if err != nil {
+			t.Errorf("Error applying %v: %v", action, err)
+			return
+		}
+		if game.Phase == poner.PhaseDiscard || game.Phase == poner.PhasePegging {
+			if _, err = game.RevealDeal(); err == nil {
+				t.Errorf("Error revealing deal, did not get err in %v after %v", game.Phase, action)
+				return
+			}
+		}
+	}
+	seed, err := game.RevealDeal()
+	if err != nil {
+		t.Errorf("Error revealing deal: %v", err)
+		return
+	}
+	_, err = poner.VerifyDeal(commitment, seed)
+	if err != nil {
+		t.Errorf("Error verifying deal: %v", err)
+	}
+}
@@ -0,0 +1,60 @@
+package poner
+
+import (
+	"math"
+	"math/rand"
+)
+
+// Strategy makes the discard and pegging decisions for a computer player,
+// seeing only what the player is allowed to see
+type Strategy interface {
+	ChooseDiscard(view PlayerView) Discard
+	ChoosePlay(view PlayerView) (card Card, cantPlay bool)
+}
+
+// DefaultStrategy is the built in computer player, picking from the best
+// discards and plays with a random offset for lower skill levels
+type DefaultStrategy struct{}
+
+// ChooseDiscard picks a discard from the hand's ranked discards
+func (strategy DefaultStrategy) ChooseDiscard(view PlayerView) Discard {
+	deck := view.UnseenDeck()
+	discards := view.rules().GetHolds(view.Hand, &deck, view.IsDealer())
+	return discards[skillAdjust(view.Random, view.SkillLevel, len(discards))]
+}
+
+// ChoosePlay picks a card from the hand's ranked plays
+func (strategy DefaultStrategy) ChoosePlay(view PlayerView) (card Card, cantPlay bool) {
+	plays, cantPlay := view.rules().GetPlays(view.Hand, view.Field, view.NextPlayer())
+	if cantPlay {
+		return
+	}
+	card = plays[skillAdjust(view.Random, view.SkillLevel, len(plays))].Card
+	return
+}
+
+// skillAdjust gets a random offset into ranked choices for a skill level
+func skillAdjust(random *rand.Rand, skillLevel int, maxAdjust int) int {
+	if random == nil {
+		random = newRandom()
+	}
+	maxSkilllevel := math.Min(4, float64(skillLevel))
+	largestOffset := math.Min(5-maxSkilllevel, float64(maxAdjust))
+	largestOffset = math.Max(largestOffset, 1)
+	return random.Intn(int(largestOffset))
+}
+
+// isFrom returns whether a discard splits exactly the cards of a hand
+func (discard Discard) isFrom(hand Hand, held int) bool {
+	if len(discard.Held) != held || len(discard.Held)+len(discard.Discarded) != len(hand) {
+		return false
+	}
+	remaining := copyHand(hand)
+	for _, card := range append(copyHand(discard.Held), discard.Discarded...) {
+		if !remaining.Contains(card) {
+			return false
+		}
+		remaining = remaining.RemoveCard(card)
+	}
+	return true
+}
@@ -0,0 +1,104 @@
+package poner_test
+
+import (
+	"testing"
+
+	"github.com/blakecallens/poner"
+)
+
+// scriptedStrategy discards the first dealt cards and plays the first playable card
+type scriptedStrategy struct {
+	discards int
+	plays    int
+}
+
+func (strategy *scriptedStrategy) ChooseDiscard(view poner.PlayerView) poner.Discard {
+	strategy.discards++
+	return poner.Discard{Held: view.Hand[len(view.Hand)-4:], Discarded: view.Hand[:len(view.Hand)-4]}
+}
+
+func (strategy *scriptedStrategy) ChoosePlay(view poner.PlayerView) (card poner.Card, cantPlay bool) {
+	for _, card := range view.Hand {
+		if card.CanBePlayed(view.Field) {
+			strategy.plays++
+			return card, false
+		}
+	}
+	return card, true
+}
+
+// cheatingStrategy tries to discard cards it wasn't dealt
+type cheatingStrategy struct {
+	poner.DefaultStrategy
+}
+
+func (strategy cheatingStrategy) ChooseDiscard(view poner.PlayerView) poner.Discard {
+	discard := strategy.DefaultStrategy.ChooseDiscard(view)
+	discard.Discarded = poner.Hand{discard.Held[0], discard.Held[0]}
+	return discard
+}
+
+func TestScriptedStrategy(t *testing.T) {
+	scripted := &scriptedStrategy{}
+	players := []poner.Player{
+		{Name: "Bob", IsComputer: true, SkillLevel: 4},
+		{Name: "Sue", IsComputer: true, Strategy: scripted},
+	}
+	game := poner.Game{}
+	game.New(players)
+	playUntilOver(t, &game)
+	if scripted.discards == 0 || scripted.plays == 0 {
+		t.Errorf("Error using strategy, got %v discards and %v plays, want some", scripted.discards, scripted.plays)
+	}
+	var dealt poner.Hand
+	for _, event := range game.Events {
+		if event.Type == poner.EventDeal {
+			dealt = event.Hands[1]
+		}
+		if event.Type != poner.EventDiscard || event.Player != 1 {
+			continue
+		}
+		if event.Cards[0] != dealt[0] || event.Cards[1] != dealt[1] {
+			t.Errorf("Error using strategy, got discard %v, want first cards of %v", event.Cards, dealt)
+		}
+	}
+}
+
+func TestInvalidStrategy(t *testing.T) {
+	players := []poner.Player{
+		{Name: "Bob", IsComputer: true, Strategy: cheatingStrategy{}},
+		{Name: "Sue", IsComputer: true},
+	}
+	game := poner.Game{}
+	game.New(players)
+	_, err := game.NextRound()
+	if err == nil {
+		t.Error("Error using strategy, did not get err for invalid discard")
+	}
+}
+
+func TestHouseRulesDiscard(t *testing.T) {
+	deck := poner.Deck{}.New()
+	hand, err := deck.PullCards("Jd 3s 7d 4c 9d 2s")
+	if err != nil {
+		t.Errorf("Error pulling cards from deck: %v", err)
+		return
+	}
+	view := poner.PlayerView{
+		Hand:       hand,
+		Players:    []poner.PublicPlayer{{Cards: 6}, {Cards: 6}},
+		SkillLevel: 4,
+		Rules:      poner.SixCardRules,
+	}
+	discard := poner.DefaultStrategy{}.ChooseDiscard(view)
+	if discard.Discarded.Contains(hand[0]) {
+		t.Errorf("Error choosing discard, got %v, want the jack held", discard.Discarded)
+	}
+
+	// With eight for nobs the dealer puts the jack in their own crib
+	view.Rules.Nobs = 8
+	discard = poner.DefaultStrategy{}.ChooseDiscard(view)
+	if !discard.Discarded.Contains(hand[0]) {
+		t.Errorf("Error choosing discard with eight for nobs, got %v, want the jack discarded", discard.Discarded)
+	}
+}
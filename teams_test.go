@@ -0,0 +1,115 @@
+package poner_test
+
+import (
+	"bytes"
+	"reflect"
+	"testing"
+
+	"github.com/blakecallens/poner"
+)
+
+// teamPlayers returns four computer players for a team game
+func teamPlayers() []poner.Player {
+	return []poner.Player{
+		{Name: "Bob", IsComputer: true, SkillLevel: 4},
+		{Name: "Sue", IsComputer: true, SkillLevel: 4},
+		{Name: "Dan", IsComputer: true, SkillLevel: 4},
+		{Name: "Joe", IsComputer: true, SkillLevel: 4},
+	}
+}
+
+func TestTeams(t *testing.T) {
+	game := poner.Game{Teams: true}
+	game.SetSeed(4)
+	game.New(teamPlayers())
+	if !game.IsPartner(0, 2) || game.IsPartner(0, 1) || game.IsPartner(1, 1) || game.Team(3) != 1 {
+		t.Error("Error seating teams, partners should sit across from each other")
+	}
+
+	playUntil(t, &game, func(game *poner.Game) bool {
+		// Partners share a peg track
+		for ii := 0; ii < 2; ii++ {
+			if game.Players[ii].Score != game.Players[ii+2].Score {
+				t.Fatalf("Error scoring teams, got %v and %v for partners", game.Players[ii].Score,
+					game.Players[ii+2].Score)
+			}
+		}
+		return game.Phase == poner.PhaseGameOver
+	})
+	if game.Winner.Score < game.ToWin {
+		t.Errorf("Error winning team game, got %v points, want %v", game.Winner.Score, game.ToWin)
+	}
+
+	written := bytes.Buffer{}
+	err := game.WriteNotation(&written)
+	if err != nil {
+		t.Errorf("Error writing notation: %v", err)
+		return
+	}
+	events, err := poner.ParseNotation(&written)
+	if err != nil {
+		t.Errorf("Error parsing notation: %v", err)
+		return
+	}
+	replayed, err := poner.Replay(events, len(events))
+	if err != nil || !replayed.Teams || !reflect.DeepEqual(replayed.Scores(), game.Scores()) {
+		t.Errorf("Error replaying team game, got %v, want %v, %v", replayed.Scores(), game.Scores(), err)
+	}
+}
+
+func TestTeamsNeedFourPlayers(t *testing.T) {
+	game := poner.Game{Teams: true}
+	game.New(teamPlayers()[:3])
+	if game.HasTeams() || game.IsPartner(0, 2) {
+		t.Error("Error seating teams, three players can't play in teams")
+	}
+}
+
+func TestTeamMatch(t *testing.T) {
+	match := poner.Match{BestOf: 3, Teams: true}
+	match.SetSeed(2)
+	match.New(teamPlayers())
+	for !match.IsOver() {
+		game, err := match.NextGame()
+		if err != nil {
+			t.Errorf("Error starting game: %v", err)
+			return
+		}
+		playUntilOver(t, game)
+		result, err := match.FinishGame()
+		if err != nil {
+			t.Errorf("Error finishing game: %v", err)
+			return
+		}
+		if result.Scores[(result.Winner+1)%4] >= game.ToWin {
+			t.Errorf("Error finishing game, the losing team has %v points", result.Scores[(result.Winner+1)%4])
+		}
+	}
+	for ii := 0; ii < 2; ii++ {
+		if match.Points[ii] != match.Points[ii+2] || match.Players[ii].GamesWon != match.Players[ii+2].GamesWon {
+			t.Errorf("Error scoring team match, got points %v", match.Points)
+		}
+	}
+	if match.Players[0].GamesWon+match.Players[1].GamesWon != len(match.Results) {
+		t.Errorf("Error scoring team match, got %v games won, want %v",
+			match.Players[0].GamesWon+match.Players[1].GamesWon, len(match.Results))
+	}
+}
+
+func TestTeamMuggins(t *testing.T) {
+	game := poner.Game{Teams: true, Muggins: true}
+	game.New([]poner.Player{{Name: "Bob"}, {Name: "Sue"}, {Name: "Dan"}, {Name: "Joe"}})
+	game.Missed = &poner.MissedScore{Player: 0, Points: 4}
+	_, err := game.Apply(poner.Action{Type: poner.ActionMuggins, Player: 2})
+	if err == nil {
+		t.Error("Error calling muggins, a partner took their team's points")
+	}
+	_, err = game.Apply(poner.Action{Type: poner.ActionMuggins, Player: 3})
+	if err != nil {
+		t.Errorf("Error calling muggins: %v", err)
+		return
+	}
+	if game.Players[1].Score != 4 || game.Players[3].Score != 4 {
+		t.Errorf("Error calling muggins, got scores %v, want 4 for the second team", game.Scores())
+	}
+}
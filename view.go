@@ -0,0 +1,125 @@
+package poner
+
+import (
+	"math/rand"
+)
+
+// PublicPlayer is what every player can see of a player
+type PublicPlayer struct {
+	Name   string
+	Score  int
+	Cards  int
+	Played Hand
+	Gone   bool
+}
+
+// PlayerView is everything a player is allowed to see when making a decision:
+// their own cards, the public state of every player, the field and the
+// round's pegging, the starter once it is cut and who owns the crib. Hand is
+// the dealt hand until the player discards, then the cards left to play
+type PlayerView struct {
+	Index      int
+	Hand       Hand
+	Dealt      Hand
+	Discarded  Hand
+	Played     Hand
+	Field      Hand
+	Pegging    []Event
+	Starter    Card
+	Dealer     int
+	Players    []PublicPlayer
+	Scores     []int
+	ToWin      int
+	Rules      Rules
+	SkillLevel int
+	Random     *rand.Rand
+}
+
+// Public returns what every player can see of the player
+func (player Player) Public() PublicPlayer {
+	return PublicPlayer{
+		Name:   player.Name,
+		Score:  player.Score,
+		Cards:  len(player.PlayingHand),
+		Played: copyHand(player.Discard.Played),
+		Gone:   player.Gone,
+	}
+}
+
+// PlayerView returns what a player can see of the game
+func (game *Game) PlayerView(index int) (view PlayerView) {
+	player := game.Players[index]
+	view = PlayerView{
+		Index:      index,
+		Hand:       copyHand(player.PlayingHand),
+		Dealt:      copyHand(player.DealtHand),
+		Discarded:  copyHand(player.Discard.Discarded),
+		Played:     copyHand(player.Discard.Played),
+		Field:      copyHand(game.Field),
+		Pegging:    game.roundPegging(),
+		Dealer:     game.Dealer,
+		Players:    []PublicPlayer{},
+		Scores:     game.Scores(),
+		ToWin:      game.ToWin,
+		Rules:      game.rules(),
+		SkillLevel: player.SkillLevel,
+		Random:     player.random(),
+	}
+	if len(player.Discard.Held) == 0 {
+		view.Hand = copyHand(player.DealtHand)
+	}
+	if game.Phase != PhaseDeal && game.Phase != PhaseDiscard && game.Phase != PhaseCut {
+		view.Starter = game.Starter
+	}
+	for _, other := range game.Players {
+		view.Players = append(view.Players, other.Public())
+	}
+	return
+}
+
+// IsDealer returns whether the player owns the crib
+func (view PlayerView) IsDealer() bool {
+	return view.Index == view.Dealer
+}
+
+// rules returns the rules of the view's game, which are six-card rules if none are set
+func (view PlayerView) rules() Rules {
+	return view.Rules.orDefault()
+}
+
+// NextPlayer returns the public state of the player to the left
+func (view PlayerView) NextPlayer() PublicPlayer {
+	if len(view.Players) == 0 {
+		return PublicPlayer{}
+	}
+	return view.Players[(view.Index+1)%len(view.Players)]
+}
+
+// Unseen returns the cards the player hasn't seen: not dealt to them, not the starter and not played
+func (view PlayerView) Unseen() (unseen Hand) {
+	unseen = Hand{}
+	for _, card := range (Deck{}).New().Cards {
+		if card == view.Starter || view.Dealt.Contains(card) || view.Hand.Contains(card) || view.wasPlayed(card) {
+			continue
+		}
+		unseen = append(unseen, card)
+	}
+	return
+}
+
+// UnseenDeck returns a deck of the cards the player hasn't seen, for ranking discards
+func (view PlayerView) UnseenDeck() (deck Deck) {
+	deck = Deck{Cards: view.Unseen(), Random: view.Random}
+	deck.GetFrequencies()
+	return
+}
+
+// wasPlayed returns whether any player has played a card this round
+func (view PlayerView) wasPlayed(card Card) bool {
+	for _, player := range view.Players {
+		if player.Played.Contains(card) {
+			return true
+		}
+	}
+	return false
+}
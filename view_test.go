@@ -0,0 +1,114 @@
+package poner_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/blakecallens/poner"
+)
+
+// spyStrategy records the views it is given
+type spyStrategy struct {
+	poner.DefaultStrategy
+	views []poner.PlayerView
+}
+
+func (strategy *spyStrategy) ChooseDiscard(view poner.PlayerView) poner.Discard {
+	strategy.views = append(strategy.views, view)
+	return strategy.DefaultStrategy.ChooseDiscard(view)
+}
+
+func (strategy *spyStrategy) ChoosePlay(view poner.PlayerView) (card poner.Card, cantPlay bool) {
+	strategy.views = append(strategy.views, view)
+	return strategy.DefaultStrategy.ChoosePlay(view)
+}
+
+func TestPlayerView(t *testing.T) {
+	spy := &spyStrategy{}
+	players := []poner.Player{
+		{Name: "Bob", IsComputer: true, SkillLevel: 4, Strategy: spy},
+		{Name: "Sue", IsComputer: true, SkillLevel: 4},
+	}
+	game := poner.Game{}
+	game.SetSeed(5)
+	game.New(players)
+	_, err := game.Apply(poner.Action{Type: poner.ActionDeal, Player: game.PlayerToAct()})
+	if err != nil {
+		t.Errorf("Error dealing: %v", err)
+		return
+	}
+	if len(spy.views) != 1 {
+		t.Errorf("Error viewing game, got %v discard views, want 1", len(spy.views))
+		return
+	}
+
+	discardView := spy.views[0]
+	if discardView.Starter != (poner.Card{}) {
+		t.Errorf("Error viewing game, got starter %v before the cut", discardView.Starter)
+	}
+	if len(discardView.Hand) != 6 || len(discardView.Unseen()) != 46 {
+		t.Errorf("Error viewing game, got %v cards and %v unseen, want 6 and 46", len(discardView.Hand), len(discardView.Unseen()))
+	}
+	// The opponent's hand is indistinguishable from the rest of the deck
+	for _, card := range game.Players[1].DealtHand {
+		if !discardView.Unseen().Contains(card) {
+			t.Errorf("Error viewing game, %v was not unseen", card)
+		}
+	}
+
+	playUntil(t, &game, func(game *poner.Game) bool {
+		return game.Phase != poner.PhasePegging
+	})
+	playView := spy.views[1]
+	if playView.Starter != game.Starter || len(playView.Players) != 2 || playView.Players[1].Cards != 4 && playView.Players[1].Cards != 3 {
+		t.Errorf("Error viewing game, got %v", playView)
+	}
+	if len(playView.Unseen()) != 52-6-1-len(playView.Players[1].Played) {
+		t.Errorf("Error viewing game, got %v unseen cards during pegging", len(playView.Unseen()))
+	}
+}
+
+// drawingStrategy draws from the view's random source before every choice
+type drawingStrategy struct {
+	poner.DefaultStrategy
+	draws int
+}
+
+func (strategy drawingStrategy) ChooseDiscard(view poner.PlayerView) poner.Discard {
+	for ii := 0; ii < strategy.draws; ii++ {
+		view.Random.Int63()
+	}
+	return strategy.DefaultStrategy.ChooseDiscard(view)
+}
+
+func (strategy drawingStrategy) ChoosePlay(view poner.PlayerView) (card poner.Card, cantPlay bool) {
+	for ii := 0; ii < strategy.draws; ii++ {
+		view.Random.Int63()
+	}
+	return strategy.DefaultStrategy.ChoosePlay(view)
+}
+
+func TestViewRandom(t *testing.T) {
+	hands := [][]poner.Hand{}
+	for _, draws := range []int{0, 7} {
+		players := []poner.Player{
+			{Name: "Bob", IsComputer: true, SkillLevel: 4, Strategy: drawingStrategy{draws: draws}},
+			{Name: "Sue", IsComputer: true, SkillLevel: 4},
+		}
+		game := poner.Game{}
+		game.SetSeed(5)
+		game.New(players)
+		playUntil(t, &game, func(game *poner.Game) bool {
+			return game.Round == 2 || game.Phase == poner.PhaseGameOver
+		})
+		if game.Round != 2 {
+			t.Fatal("Error playing the first round, the game ended")
+		}
+		hands = append(hands, []poner.Hand{game.Players[0].DealtHand, game.Players[1].DealtHand})
+	}
+	for ii := range hands[0] {
+		if fmt.Sprint(hands[0][ii]) != fmt.Sprint(hands[1][ii]) {
+			t.Errorf("Error dealing, drawing from the view's random source changed hand %v from %v to %v", ii, hands[0][ii], hands[1][ii])
+		}
+	}
+}
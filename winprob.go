@@ -0,0 +1,360 @@
+package poner
+
+import (
+	"math"
+	"sync"
+)
+
+// WinProbabilityFunc returns the chance of winning a two player game from the
+// start of a round, given both scores and whether the player deals the round
+type WinProbabilityFunc func(myScore int, opponentScore int, dealer bool, toWin int) float64
+
+// The average points and spread of each part of a round, used by the analytic win probability model
+const (
+	averageHand         = 7.8
+	handSpread          = 4.5
+	averageCrib         = 4.7
+	cribSpread          = 3.5
+	averageDealerPegs   = 4.3
+	dealerPegsSpread    = 2.5
+	averagePonePegs     = 2.7
+	ponePegsSpread      = 2.2
+	hisHeelsChance      = 1.0 / 13
+	maxDistributedScore = 29
+)
+
+// The score distributions of the parts of a round
+var (
+	handDistribution       = normalDistribution(averageHand, handSpread)
+	cribDistribution       = normalDistribution(averageCrib, cribSpread)
+	dealerPegsDistribution = normalDistribution(averageDealerPegs, dealerPegsSpread)
+	ponePegsDistribution   = normalDistribution(averagePonePegs, ponePegsSpread)
+)
+
+// winTables caches the analytic win probabilities for each game length
+var winTables = struct {
+	sync.Mutex
+	model  *roundModel
+	tables map[int]*WinTable
+}{tables: map[int]*WinTable{}}
+
+// WinTable holds the chance of winning a two player game from the start of
+// every round, indexed by the points each player still needs and who deals
+type WinTable struct {
+	ToWin   int
+	Chances []float64
+}
+
+// roundPoints is the chance of the pone and dealer scoring a number of points in a round
+type roundPoints struct {
+	pone   int
+	dealer int
+	chance float64
+}
+
+// roundModel holds the chances of the points scored in a round, and of the
+// pone or the dealer reaching the points they need first, indexed by the
+// points the pone and the dealer need. Needing more points than the last
+// index is the same as needing the last index, which can't be reached
+type roundModel struct {
+	rounds      []roundPoints
+	poneFirst   [][]float64
+	dealerFirst [][]float64
+}
+
+// roundRest is what is left to score in a round, in the order it is
+// scored: his heels for the dealer, the pegging, where either player can peg
+// next, the pone's show, then the dealer's show and crib
+type roundRest struct {
+	heels      []float64
+	ponePegs   []float64
+	dealerPegs []float64
+	poneShow   []float64
+	dealerShow []float64
+}
+
+// EstimateWinProbability returns the chance of winning a two player game from
+// the start of a round, modeling each round's points as the sum of normally
+// distributed pegging, hand and crib scores, counted in the order they are
+// scored: his heels, the pegging, the pone's hand and then the dealer's
+func EstimateWinProbability(myScore int, opponentScore int, dealer bool, toWin int) float64 {
+	return getWinTable(toWin).Chance(myScore, opponentScore, dealer)
+}
+
+// getWinTable returns the cached analytic win table for a game length, building it if needed
+func getWinTable(toWin int) *WinTable {
+	winTables.Lock()
+	defer winTables.Unlock()
+	table, ok := winTables.tables[toWin]
+	if !ok {
+		if winTables.model == nil {
+			winTables.model = estimatedRest().model()
+		}
+		table = buildWinTable(toWin, winTables.model)
+		winTables.tables[toWin] = table
+	}
+	return table
+}
+
+// estimatedRest returns a whole round of the analytic model
+func estimatedRest() roundRest {
+	return roundRest{
+		heels:      []float64{1 - hisHeelsChance, 0, hisHeelsChance},
+		ponePegs:   ponePegsDistribution,
+		dealerPegs: dealerPegsDistribution,
+		poneShow:   handDistribution,
+		dealerShow: convolve(handDistribution, cribDistribution),
+	}
+}
+
+// buildWinTable solves the chance of winning for every pair of points needed
+// from a model of a round, working up from the positions closest to the end
+// of the game
+func buildWinTable(toWin int, model *roundModel) *WinTable {
+	table := &WinTable{ToWin: toWin, Chances: make([]float64, (toWin+1)*(toWin+1)*2)}
+	// Neither player scoring in a round swaps the deal without changing the
+	// scores, so the two dealer positions are solved together
+	repeat := 0.0
+	for _, round := range model.rounds {
+		if round.pone == 0 && round.dealer == 0 {
+			repeat += round.chance
+		}
+	}
+	for needed := 1; needed <= toWin; needed++ {
+		for opponentNeeded := 1; opponentNeeded <= toWin; opponentNeeded++ {
+			// Whoever reaches the points they need first during the round wins
+			pone, _ := model.first(needed, opponentNeeded)
+			_, dealer := model.first(opponentNeeded, needed)
+			for _, round := range model.rounds {
+				if round.pone == 0 && round.dealer == 0 {
+					continue
+				}
+				if round.pone < needed && round.dealer < opponentNeeded {
+					pone += round.chance * table.chance(needed-round.pone, opponentNeeded-round.dealer, true)
+				}
+				if round.dealer < needed && round.pone < opponentNeeded {
+					dealer += round.chance * table.chance(needed-round.dealer, opponentNeeded-round.pone, false)
+				}
+			}
+			pone = (pone + repeat*dealer) / (1 - repeat*repeat)
+			dealer = dealer + repeat*pone
+			table.Chances[table.index(needed, opponentNeeded, false)] = pone
+			table.Chances[table.index(needed, opponentNeeded, true)] = dealer
+		}
+	}
+	return table
+}
+
+// first returns the chances of the pone and of the dealer reaching the
+// points they need first during a round
+func (model *roundModel) first(poneNeeded int, dealerNeeded int) (pone float64, dealer float64) {
+	if len(model.poneFirst) == 0 {
+		return
+	}
+	if poneNeeded >= len(model.poneFirst) {
+		poneNeeded = len(model.poneFirst) - 1
+	}
+	if dealerNeeded >= len(model.poneFirst[poneNeeded]) {
+		dealerNeeded = len(model.poneFirst[poneNeeded]) - 1
+	}
+	return model.poneFirst[poneNeeded][dealerNeeded], model.dealerFirst[poneNeeded][dealerNeeded]
+}
+
+// newRoundModel returns a model with room for the pone and dealer needing up
+// to one more than the most points they can score in a round
+func newRoundModel(rounds []roundPoints) *roundModel {
+	maxPone, maxDealer := 0, 0
+	for _, round := range rounds {
+		if round.pone > maxPone {
+			maxPone = round.pone
+		}
+		if round.dealer > maxDealer {
+			maxDealer = round.dealer
+		}
+	}
+	model := &roundModel{rounds: rounds, poneFirst: make([][]float64, maxPone+2),
+		dealerFirst: make([][]float64, maxPone+2)}
+	for ii := range model.poneFirst {
+		model.poneFirst[ii] = make([]float64, maxDealer+2)
+		model.dealerFirst[ii] = make([]float64, maxDealer+2)
+	}
+	return model
+}
+
+// model returns the round model of a whole round
+func (rest roundRest) model() *roundModel {
+	pone, dealer := rest.totals()
+	model := newRoundModel(independentRounds(pone, dealer))
+	for poneNeeded := 1; poneNeeded < len(model.poneFirst); poneNeeded++ {
+		for dealerNeeded := 1; dealerNeeded < len(model.poneFirst[poneNeeded]); dealerNeeded++ {
+			model.poneFirst[poneNeeded][dealerNeeded], model.dealerFirst[poneNeeded][dealerNeeded] =
+				rest.first(poneNeeded, dealerNeeded)
+		}
+	}
+	return model
+}
+
+// totals returns the chances of the points the pone and the dealer score in the rest of the round
+func (rest roundRest) totals() (pone []float64, dealer []float64) {
+	pone = convolve(rest.ponePegs, rest.poneShow)
+	dealer = convolve(convolve(rest.heels, rest.dealerPegs), rest.dealerShow)
+	return
+}
+
+// first returns the chances of the pone and of the dealer reaching the
+// points they need first in the rest of the round. The pegging points are
+// taken to come in a random order, so either player can peg out first
+func (rest roundRest) first(poneNeeded int, dealerNeeded int) (pone float64, dealer float64) {
+	poneShow, dealerShow := tailChances(rest.poneShow), tailChances(rest.dealerShow)
+	for heels, heelsChance := range rest.heels {
+		if heelsChance == 0 {
+			continue
+		}
+		if heels >= dealerNeeded {
+			dealer += heelsChance
+			continue
+		}
+		for ponePegs, ponePegsChance := range rest.ponePegs {
+			for dealerPegs, dealerPegsChance := range rest.dealerPegs {
+				chance := heelsChance * ponePegsChance * dealerPegsChance
+				poneLeft, dealerLeft := poneNeeded-ponePegs, dealerNeeded-heels-dealerPegs
+				switch {
+				case poneLeft <= 0 && dealerLeft <= 0:
+					race := pegRace(poneNeeded, dealerNeeded-heels, ponePegs, dealerPegs)
+					pone += chance * race
+					dealer += chance * (1 - race)
+				case poneLeft <= 0:
+					pone += chance
+				case dealerLeft <= 0:
+					dealer += chance
+				default:
+					poneCounts := tailChance(poneShow, poneLeft)
+					pone += chance * poneCounts
+					dealer += chance * (1 - poneCounts) * tailChance(dealerShow, dealerLeft)
+				}
+			}
+		}
+	}
+	return
+}
+
+// chance returns the pone's chance of winning from the rest of a round,
+// where next is the pone's chance of winning the game when neither player
+// reaches the points they need, from the points each scored in the round
+func (rest roundRest) chance(poneNeeded int, dealerNeeded int, next func(pone int, dealer int) float64) float64 {
+	chance, _ := rest.first(poneNeeded, dealerNeeded)
+	ponePoints, dealerPoints := rest.totals()
+	for pone := 0; pone < poneNeeded && pone < len(ponePoints); pone++ {
+		for dealer := 0; dealer < dealerNeeded && dealer < len(dealerPoints); dealer++ {
+			if ponePoints[pone] > 0 && dealerPoints[dealer] > 0 {
+				chance += ponePoints[pone] * dealerPoints[dealer] * next(pone, dealer)
+			}
+		}
+	}
+	return chance
+}
+
+// pegRace returns the chance the pone pegs the points they need before the
+// dealer does, when both peg enough in a round and their points come in a
+// random order. That is the chance at least poneNeeded of the first
+// poneNeeded+dealerNeeded-1 points pegged are the pone's
+func pegRace(poneNeeded int, dealerNeeded int, ponePegs int, dealerPegs int) float64 {
+	drawn := poneNeeded + dealerNeeded - 1
+	chance := 0.0
+	for pone := poneNeeded; pone <= ponePegs && pone <= drawn; pone++ {
+		chance += combinations(ponePegs, pone) * combinations(dealerPegs, drawn-pone)
+	}
+	return chance / combinations(ponePegs+dealerPegs, drawn)
+}
+
+// tailChances returns the chances of scoring at least each number of points
+func tailChances(distribution []float64) (tail []float64) {
+	tail = make([]float64, len(distribution)+1)
+	for points := len(distribution) - 1; points >= 0; points-- {
+		tail[points] = tail[points+1] + distribution[points]
+	}
+	return
+}
+
+// tailChance returns the chance of scoring at least a number of points from tailChances
+func tailChance(tail []float64, points int) float64 {
+	if points <= 0 {
+		return 1
+	}
+	if points >= len(tail) {
+		return 0
+	}
+	return tail[points]
+}
+
+// Chance returns the chance of winning from the start of a round
+func (table *WinTable) Chance(myScore int, opponentScore int, dealer bool) float64 {
+	return table.chance(table.ToWin-myScore, table.ToWin-opponentScore, dealer)
+}
+
+// chance returns the chance of winning by the points each player still needs
+func (table *WinTable) chance(needed int, opponentNeeded int, dealer bool) float64 {
+	if needed <= 0 {
+		return 1
+	}
+	if opponentNeeded <= 0 {
+		return 0
+	}
+	if needed > table.ToWin {
+		needed = table.ToWin
+	}
+	if opponentNeeded > table.ToWin {
+		opponentNeeded = table.ToWin
+	}
+	return table.Chances[table.index(needed, opponentNeeded, dealer)]
+}
+
+func (table *WinTable) index(needed int, opponentNeeded int, dealer bool) int {
+	index := (needed*(table.ToWin+1) + opponentNeeded) * 2
+	if dealer {
+		index++
+	}
+	return index
+}
+
+// independentRounds returns the chances of a round's points from independent pone and dealer distributions
+func independentRounds(pone []float64, dealer []float64) (rounds []roundPoints) {
+	rounds = []roundPoints{}
+	for ii, poneChance := range pone {
+		for jj, dealerChance := range dealer {
+			rounds = append(rounds, roundPoints{pone: ii, dealer: jj, chance: poneChance * dealerChance})
+		}
+	}
+	return
+}
+
+// normalDistribution returns the chance of scoring each number of points
+// from a normal distribution cut off at zero and maxDistributedScore
+func normalDistribution(average float64, spread float64) (distribution []float64) {
+	distribution = make([]float64, maxDistributedScore+1)
+	total := 0.0
+	for points := range distribution {
+		distance := (float64(points) - average) / spread
+		distribution[points] = math.Exp(-distance * distance / 2)
+		total += distribution[points]
+	}
+	for points := range distribution {
+		distribution[points] /= total
+	}
+	return
+}
+
+// convolve returns the distribution of the sum of two independent scores
+func convolve(first []float64, second []float64) (distribution []float64) {
+	distribution = make([]float64, len(first)+len(second)-1)
+	for ii, firstChance := range first {
+		for jj, secondChance := range second {
+			distribution[ii+jj] += firstChance * secondChance
+		}
+	}
+	// Drop the long tail of totals too unlikely to matter
+	for len(distribution) > 1 && distribution[len(distribution)-1] < 1e-9 {
+		distribution = distribution[:len(distribution)-1]
+	}
+	return
+}
@@ -0,0 +1,1869 @@
+// Code generated by wintable -variant Six-Card -out winprob_table_121.go; DO NOT EDIT.
+
+package poner
+
+func init() {
+	winTableData[121] = []uint16{
+		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 21656, 43879, 24562, 50972, 42735, 58880, 50669, 62814, 57583, 64610,
+		61872, 65304, 63750, 65494, 64884, 65525, 65219, 65534, 65411, 65535, 65452, 65535, 65467, 65535, 65475, 65535,
+		65480, 65535, 65488, 65535, 65498, 65535, 65504, 65535, 65508, 65535, 65512, 65535, 65515, 65535, 65518, 65535,
+		65521, 65535, 65525, 65535, 65527, 65535, 65528, 65535, 65530, 65535, 65530, 65535, 65531, 65535, 65532, 65535,
+		65532, 65535, 65534, 65535, 65534, 65535, 65535, 65535, 65535, 65535, 65535, 65535, 65535, 65535, 65535, 65535,
+		65535, 65535, 65535, 65535, 65535, 65535, 65535, 65535, 65535, 65535, 65535, 65535, 65535, 65535, 65535, 65535,
+		65535, 65535, 65535, 65535, 65535, 65535, 65535, 65535, 65535, 65535, 65535, 65535, 65535, 65535, 65535, 65535,
+		65535, 65535, 65535, 65535, 65535, 65535, 65535, 65535, 65535, 65535, 65535, 65535, 65535, 65535, 65535, 65535,
+		65535, 65535, 65535, 65535, 65535, 65535, 65535, 65535, 65535, 65535, 65535, 65535, 65535, 65535, 65535, 65535,
+		65535, 65535, 65535, 65535, 65535, 65535, 65535, 65535, 65535, 65535, 65535, 65535, 65535, 65535, 65535, 65535,
+		65535, 65535, 65535, 65535, 65535, 65535, 65535, 65535, 65535, 65535, 65535, 65535, 65535, 65535, 65535, 65535,
+		65535, 65535, 65535, 65535, 65535, 65535, 65535, 65535, 65535, 65535, 65535, 65535, 65535, 65535, 65535, 65535,
+		65535, 65535, 65535, 65535, 65535, 65535, 65535, 65535, 65535, 65535, 65535, 65535, 65535, 65535, 65535, 65535,
+		65535, 65535, 65535, 65535, 65535, 65535, 65535, 65535, 65535, 65535, 65535, 65535, 65535, 65535, 65535, 65535,
+		65535, 65535, 65535, 65535, 65535, 65535, 65535, 65535, 65535, 65535, 65535, 65535, 65535, 65535, 65535, 65535,
+		65535, 65535, 65535, 65535, 65535, 65535, 65535, 65535, 0, 0, 14563, 40973, 20602, 44933, 37185, 48997,
+		47505, 51188, 55973, 51974, 61027, 53062, 63444, 53793, 64759, 55171, 65134, 56375, 65352, 58173, 65397, 59657,
+		65416, 61100, 65426, 61976, 65433, 63044, 65442, 63762, 65455, 64304, 65462, 64657, 65471, 64971, 65477, 65182,
+		65485, 65287, 65492, 65351, 65500, 65407, 65507, 65454, 65513, 65485, 65517, 65496, 65521, 65517, 65523, 65525,
+		65525, 65530, 65527, 65532, 65528, 65535, 65530, 65535, 65532, 65535, 65532, 65535, 65533, 65535, 65533, 65535,
+		65534, 65535, 65534, 65535, 65534, 65535, 65534, 65535, 65534, 65535, 65535, 65535, 65535, 65535, 65535, 65535,
+		65535, 65535, 65535, 65535, 65535, 65535, 65535, 65535, 65535, 65535, 65535, 65535, 65535, 65535, 65535, 65535,
+		65535, 65535, 65535, 65535, 65535, 65535, 65535, 65535, 65535, 65535, 65535, 65535, 65535, 65535, 65535, 65535,
+		65535, 65535, 65535, 65535, 65535, 65535, 65535, 65535, 65535, 65535, 65535, 65535, 65535, 65535, 65535, 65535,
+		65535, 65535, 65535, 65535, 65535, 65535, 65535, 65535, 65535, 65535, 65535, 65535, 65535, 65535, 65535, 65535,
+		65535, 65535, 65535, 65535, 65535, 65535, 65535, 65535, 65535, 65535, 65535, 65535, 65535, 65535, 65535, 65535,
+		65535, 65535, 65535, 65535, 65535, 65535, 65535, 65535, 65535, 65535, 65535, 65535, 65535, 65535, 65535, 65535,
+		65535, 65535, 65535, 65535, 65535, 65535, 65535, 65535, 65535, 65535, 65535, 65535, 65535, 65535, 65535, 65535,
+		65535, 65535, 65535, 65535, 65535, 65535, 65535, 65535, 65535, 65535, 65535, 65535, 65535, 65535, 65535, 65535,
+		65535, 65535, 65535, 65535, 65535, 65535, 65535, 65535, 65535, 65535, 65535, 65535, 65535, 65535, 65535, 65535,
+		65535, 65535, 65535, 65535, 65535, 65535, 65535, 65535, 65535, 65535, 65535, 65535, 0, 0, 6655, 22800,
+		16538, 28350, 29088, 36447, 43317, 39803, 52531, 42267, 59243, 44141, 62126, 45900, 63865, 48286, 64328, 50698,
+		64616, 53793, 64688, 56189, 64743, 58304, 64795, 59944, 64849, 61627, 64912, 62725, 64993, 63498, 65067, 64066,
+		65142, 64606, 65206, 64908, 65268, 65064, 65313, 65192, 65352, 65309, 65387, 65389, 65419, 65428, 65446, 65463,
+		65467, 65506, 65486, 65525, 65497, 65530, 65508, 65532, 65514, 65535, 65519, 65535, 65523, 65535, 65526, 65535,
+		65529, 65535, 65530, 65535, 65531, 65535, 65532, 65535, 65533, 65535, 65533, 65535, 65534, 65535, 65534, 65535,
+		65534, 65535, 65535, 65535, 65535, 65535, 65535, 65535, 65535, 65535, 65535, 65535, 65535, 65535, 65535, 65535,
+		65535, 65535, 65535, 65535, 65535, 65535, 65535, 65535, 65535, 65535, 65535, 65535, 65535, 65535, 65535, 65535,
+		65535, 65535, 65535, 65535, 65535, 65535, 65535, 65535, 65535, 65535, 65535, 65535, 65535, 65535, 65535, 65535,
+		65535, 65535, 65535, 65535, 65535, 65535, 65535, 65535, 65535, 65535, 65535, 65535, 65535, 65535, 65535, 65535,
+		65535, 65535, 65535, 65535, 65535, 65535, 65535, 65535, 65535, 65535, 65535, 65535, 65535, 65535, 65535, 65535,
+		65535, 65535, 65535, 65535, 65535, 65535, 65535, 65535, 65535, 65535, 65535, 65535, 65535, 65535, 65535, 65535,
+		65535, 65535, 65535, 65535, 65535, 65535, 65535, 65535, 65535, 65535, 65535, 65535, 65535, 65535, 65535, 65535,
+		65535, 65535, 65535, 65535, 65535, 65535, 65535, 65535, 65535, 65535, 65535, 65535, 65535, 65535, 65535, 65535,
+		65535, 65535, 65535, 65535, 65535, 65535, 65535, 65535, 65535, 65535, 65535, 65535, 65535, 65535, 65535, 65535,
+		65535, 65535, 65535, 65535, 65535, 65535, 65535, 65535, 65535, 65535, 65535, 65535, 65535, 65535, 65535, 65535,
+		0, 0, 2721, 14866, 14347, 18030, 25732, 22218, 41399, 24136, 50763, 26167, 57955, 28717, 60930, 31781,
+		62780, 35765, 63284, 40274, 63606, 45473, 63709, 49789, 63815, 53319, 63916, 56356, 64033, 58973, 64161, 60824,
+		64314, 62092, 64450, 63180, 64594, 64020, 64725, 64523, 64856, 64789, 64967, 65000, 65061, 65192, 65144, 65309,
+		65217, 65366, 65278, 65425, 65330, 65482, 65371, 65516, 65401, 65524, 65430, 65530, 65450, 65534, 65467, 65534,
+		65482, 65535, 65494, 65535, 65503, 65535, 65511, 65535, 65516, 65535, 65521, 65535, 65524, 65535, 65527, 65535,
+		65529, 65535, 65531, 65535, 65532, 65535, 65533, 65535, 65533, 65535, 65534, 65535, 65534, 65535, 65535, 65535,
+		65535, 65535, 65535, 65535, 65535, 65535, 65535, 65535, 65535, 65535, 65535, 65535, 65535, 65535, 65535, 65535,
+		65535, 65535, 65535, 65535, 65535, 65535, 65535, 65535, 65535, 65535, 65535, 65535, 65535, 65535, 65535, 65535,
+		65535, 65535, 65535, 65535, 65535, 65535, 65535, 65535, 65535, 65535, 65535, 65535, 65535, 65535, 65535, 65535,
+		65535, 65535, 65535, 65535, 65535, 65535, 65535, 65535, 65535, 65535, 65535, 65535, 65535, 65535, 65535, 65535,
+		65535, 65535, 65535, 65535, 65535, 65535, 65535, 65535, 65535, 65535, 65535, 65535, 65535, 65535, 65535, 65535,
+		65535, 65535, 65535, 65535, 65535, 65535, 65535, 65535, 65535, 65535, 65535, 65535, 65535, 65535, 65535, 65535,
+		65535, 65535, 65535, 65535, 65535, 65535, 65535, 65535, 65535, 65535, 65535, 65535, 65535, 65535, 65535, 65535,
+		65535, 65535, 65535, 65535, 65535, 65535, 65535, 65535, 65535, 65535, 65535, 65535, 65535, 65535, 65535, 65535,
+		65535, 65535, 65535, 65535, 65535, 65535, 65535, 65535, 65535, 65535, 65535, 65535, 65535, 65535, 65535, 65535,
+		65535, 65535, 65535, 65535, 0, 0, 925, 7952, 13561, 9562, 23268, 13004, 39368, 14772, 48089, 17446,
+		55176, 20533, 57999, 24451, 59803, 29345, 60316, 34895, 60678, 41307, 60857, 46527, 61078, 50743, 61318, 54451,
+		61602, 57587, 61928, 59832, 62296, 61328, 62647, 62656, 63011, 63716, 63360, 64294, 63702, 64612, 63990, 64863,
+		64261, 65089, 64490, 65220, 64689, 65299, 64846, 65400, 64982, 65472, 65090, 65508, 65177, 65520, 65250, 65530,
+		65307, 65533, 65354, 65534, 65394, 65534, 65427, 65534, 65451, 65535, 65471, 65535, 65486, 65535, 65498, 65535,
+		65507, 65535, 65515, 65535, 65520, 65535, 65524, 65535, 65527, 65535, 65530, 65535, 65531, 65535, 65532, 65535,
+		65533, 65535, 65534, 65535, 65534, 65535, 65534, 65535, 65535, 65535, 65535, 65535, 65535, 65535, 65535, 65535,
+		65535, 65535, 65535, 65535, 65535, 65535, 65535, 65535, 65535, 65535, 65535, 65535, 65535, 65535, 65535, 65535,
+		65535, 65535, 65535, 65535, 65535, 65535, 65535, 65535, 65535, 65535, 65535, 65535, 65535, 65535, 65535, 65535,
+		65535, 65535, 65535, 65535, 65535, 65535, 65535, 65535, 65535, 65535, 65535, 65535, 65535, 65535, 65535, 65535,
+		65535, 65535, 65535, 65535, 65535, 65535, 65535, 65535, 65535, 65535, 65535, 65535, 65535, 65535, 65535, 65535,
+		65535, 65535, 65535, 65535, 65535, 65535, 65535, 65535, 65535, 65535, 65535, 65535, 65535, 65535, 65535, 65535,
+		65535, 65535, 65535, 65535, 65535, 65535, 65535, 65535, 65535, 65535, 65535, 65535, 65535, 65535, 65535, 65535,
+		65535, 65535, 65535, 65535, 65535, 65535, 65535, 65535, 65535, 65535, 65535, 65535, 65535, 65535, 65535, 65535,
+		65535, 65535, 65535, 65535, 65535, 65535, 65535, 65535, 65535, 65535, 65535, 65535, 65535, 65535, 65535, 65535,
+		65535, 65535, 65535, 65535, 65535, 65535, 65535, 65535, 0, 0, 231, 3663, 12473, 4508, 21394, 6292,
+		36818, 7580, 45002, 10359, 51820, 13715, 54520, 18447, 56279, 23973, 56817, 30593, 57248, 37842, 57513, 43944,
+		57880, 48676, 58277, 53033, 58776, 56483, 59283, 59058, 59886, 60722, 60445, 62300, 61034, 63478, 61581, 64152,
+		62127, 64503, 62599, 64799, 63042, 65042, 63431, 65189, 63777, 65279, 64061, 65387, 64314, 65463, 64523, 65503,
+		64698, 65517, 64848, 65529, 64975, 65532, 65086, 65533, 65177, 65534, 65254, 65534, 65315, 65535, 65366, 65535,
+		65405, 65535, 65436, 65535, 65461, 65535, 65480, 65535, 65494, 65535, 65505, 65535, 65513, 65535, 65519, 65535,
+		65524, 65535, 65527, 65535, 65530, 65535, 65531, 65535, 65533, 65535, 65533, 65535, 65534, 65535, 65534, 65535,
+		65535, 65535, 65535, 65535, 65535, 65535, 65535, 65535, 65535, 65535, 65535, 65535, 65535, 65535, 65535, 65535,
+		65535, 65535, 65535, 65535, 65535, 65535, 65535, 65535, 65535, 65535, 65535, 65535, 65535, 65535, 65535, 65535,
+		65535, 65535, 65535, 65535, 65535, 65535, 65535, 65535, 65535, 65535, 65535, 65535, 65535, 65535, 65535, 65535,
+		65535, 65535, 65535, 65535, 65535, 65535, 65535, 65535, 65535, 65535, 65535, 65535, 65535, 65535, 65535, 65535,
+		65535, 65535, 65535, 65535, 65535, 65535, 65535, 65535, 65535, 65535, 65535, 65535, 65535, 65535, 65535, 65535,
+		65535, 65535, 65535, 65535, 65535, 65535, 65535, 65535, 65535, 65535, 65535, 65535, 65535, 65535, 65535, 65535,
+		65535, 65535, 65535, 65535, 65535, 65535, 65535, 65535, 65535, 65535, 65535, 65535, 65535, 65535, 65535, 65535,
+		65535, 65535, 65535, 65535, 65535, 65535, 65535, 65535, 65535, 65535, 65535, 65535, 65535, 65535, 65535, 65535,
+		65535, 65535, 65535, 65535, 65535, 65535, 65535, 65535, 65535, 65535, 65535, 65535, 0, 0, 41, 1785,
+		11742, 2091, 19635, 3409, 33754, 4605, 41084, 7536, 47088, 11015, 49496, 16039, 51070, 21806, 51610, 28767,
+		52102, 36391, 52480, 42839, 53035, 47802, 53634, 52355, 54390, 55933, 55170, 58655, 56078, 60397, 56952, 62080,
+		57908, 63338, 58758, 64053, 59638, 64433, 60409, 64747, 61139, 65007, 61772, 65160, 62356, 65255, 62835, 65373,
+		63275, 65452, 63641, 65497, 63965, 65514, 64237, 65527, 64474, 65531, 64676, 65532, 64844, 65533, 64987, 65533,
+		65106, 65534, 65204, 65534, 65280, 65534, 65340, 65534, 65389, 65535, 65425, 65535, 65454, 65535, 65476, 65535,
+		65492, 65535, 65504, 65535, 65513, 65535, 65520, 65535, 65524, 65535, 65528, 65535, 65530, 65535, 65532, 65535,
+		65533, 65535, 65534, 65535, 65534, 65535, 65534, 65535, 65535, 65535, 65535, 65535, 65535, 65535, 65535, 65535,
+		65535, 65535, 65535, 65535, 65535, 65535, 65535, 65535, 65535, 65535, 65535, 65535, 65535, 65535, 65535, 65535,
+		65535, 65535, 65535, 65535, 65535, 65535, 65535, 65535, 65535, 65535, 65535, 65535, 65535, 65535, 65535, 65535,
+		65535, 65535, 65535, 65535, 65535, 65535, 65535, 65535, 65535, 65535, 65535, 65535, 65535, 65535, 65535, 65535,
+		65535, 65535, 65535, 65535, 65535, 65535, 65535, 65535, 65535, 65535, 65535, 65535, 65535, 65535, 65535, 65535,
+		65535, 65535, 65535, 65535, 65535, 65535, 65535, 65535, 65535, 65535, 65535, 65535, 65535, 65535, 65535, 65535,
+		65535, 65535, 65535, 65535, 65535, 65535, 65535, 65535, 65535, 65535, 65535, 65535, 65535, 65535, 65535, 65535,
+		65535, 65535, 65535, 65535, 65535, 65535, 65535, 65535, 65535, 65535, 65535, 65535, 65535, 65535, 65535, 65535,
+		65535, 65535, 65535, 65535, 65535, 65535, 65535, 65535, 65535, 65535, 65535, 65535, 65535, 65535, 65535, 65535,
+		0, 0, 10, 651, 10364, 776, 17249, 1670, 29770, 2755, 36190, 5732, 41562, 9256, 43729, 14465,
+		45201, 20334, 45767, 27551, 46331, 35300, 46821, 41972, 47577, 47073, 48379, 51801, 49421, 55441, 50471, 58285,
+		51703, 60099, 52876, 61876, 54177, 63187, 55323, 63943, 56511, 64351, 57546, 64690, 58563, 64963, 59461, 65125,
+		60309, 65228, 61033, 65354, 61708, 65438, 62291, 65488, 62821, 65506, 63274, 65521, 63676, 65526, 64020, 65528,
+		64312, 65530, 64560, 65531, 64769, 65532, 64940, 65532, 65075, 65533, 65183, 65533, 65269, 65534, 65335, 65534,
+		65387, 65534, 65426, 65534, 65456, 65534, 65478, 65535, 65494, 65535, 65506, 65535, 65515, 65535, 65521, 65535,
+		65526, 65535, 65529, 65535, 65531, 65535, 65532, 65535, 65533, 65535, 65534, 65535, 65534, 65535, 65535, 65535,
+		65535, 65535, 65535, 65535, 65535, 65535, 65535, 65535, 65535, 65535, 65535, 65535, 65535, 65535, 65535, 65535,
+		65535, 65535, 65535, 65535, 65535, 65535, 65535, 65535, 65535, 65535, 65535, 65535, 65535, 65535, 65535, 65535,
+		65535, 65535, 65535, 65535, 65535, 65535, 65535, 65535, 65535, 65535, 65535, 65535, 65535, 65535, 65535, 65535,
+		65535, 65535, 65535, 65535, 65535, 65535, 65535, 65535, 65535, 65535, 65535, 65535, 65535, 65535, 65535, 65535,
+		65535, 65535, 65535, 65535, 65535, 65535, 65535, 65535, 65535, 65535, 65535, 65535, 65535, 65535, 65535, 65535,
+		65535, 65535, 65535, 65535, 65535, 65535, 65535, 65535, 65535, 65535, 65535, 65535, 65535, 65535, 65535, 65535,
+		65535, 65535, 65535, 65535, 65535, 65535, 65535, 65535, 65535, 65535, 65535, 65535, 65535, 65535, 65535, 65535,
+		65535, 65535, 65535, 65535, 65535, 65535, 65535, 65535, 65535, 65535, 65535, 65535, 65535, 65535, 65535, 65535,
+		65535, 65535, 65535, 65535, 0, 0, 1, 316, 9160, 401, 14837, 1207, 25261, 2251, 30640, 5219,
+		34942, 8718, 36768, 13925, 37984, 19768, 38536, 26999, 39162, 34728, 39778, 41442, 40724, 46605, 41743, 51400,
+		43082, 55083, 44431, 57988, 46024, 59855, 47526, 61683, 49216, 63037, 50741, 63828, 52330, 64262, 53738, 64622,
+		55136, 64911, 56405, 65085, 57600, 65198, 58659, 65329, 59649, 65416, 60521, 65470, 61322, 65492, 62020, 65509,
+		62641, 65517, 63165, 65520, 63619, 65523, 64005, 65525, 64327, 65527, 64594, 65528, 64809, 65529, 64980, 65530,
+		65115, 65531, 65220, 65532, 65301, 65532, 65363, 65533, 65410, 65533, 65445, 65534, 65471, 65534, 65489, 65534,
+		65503, 65534, 65513, 65535, 65520, 65535, 65525, 65535, 65528, 65535, 65530, 65535, 65532, 65535, 65533, 65535,
+		65534, 65535, 65534, 65535, 65535, 65535, 65535, 65535, 65535, 65535, 65535, 65535, 65535, 65535, 65535, 65535,
+		65535, 65535, 65535, 65535, 65535, 65535, 65535, 65535, 65535, 65535, 65535, 65535, 65535, 65535, 65535, 65535,
+		65535, 65535, 65535, 65535, 65535, 65535, 65535, 65535, 65535, 65535, 65535, 65535, 65535, 65535, 65535, 65535,
+		65535, 65535, 65535, 65535, 65535, 65535, 65535, 65535, 65535, 65535, 65535, 65535, 65535, 65535, 65535, 65535,
+		65535, 65535, 65535, 65535, 65535, 65535, 65535, 65535, 65535, 65535, 65535, 65535, 65535, 65535, 65535, 65535,
+		65535, 65535, 65535, 65535, 65535, 65535, 65535, 65535, 65535, 65535, 65535, 65535, 65535, 65535, 65535, 65535,
+		65535, 65535, 65535, 65535, 65535, 65535, 65535, 65535, 65535, 65535, 65535, 65535, 65535, 65535, 65535, 65535,
+		65535, 65535, 65535, 65535, 65535, 65535, 65535, 65535, 65535, 65535, 65535, 65535, 65535, 65535, 65535, 65535,
+		65535, 65535, 65535, 65535, 65535, 65535, 65535, 65535, 0, 0, 0, 124, 7362, 183, 11742, 919,
+		20062, 1929, 24228, 4857, 27693, 8287, 29144, 13433, 30235, 19204, 30807, 26373, 31533, 34002, 32286, 40719,
+		33416, 45913, 34630, 50768, 36240, 54506, 37840, 57488, 39758, 59435, 41580, 61343, 43631, 62752, 45511, 63603,
+		47475, 64089, 49269, 64491, 51053, 64808, 52700, 65002, 54281, 65130, 55717, 65274, 57078, 65369, 58297, 65431,
+		59424, 65460, 60416, 65481, 61305, 65493, 62063, 65500, 62727, 65506, 63291, 65510, 63761, 65515, 64150, 65518,
+		64466, 65521, 64716, 65523, 64914, 65525, 65068, 65527, 65187, 65529, 65278, 65530, 65346, 65531, 65398, 65532,
+		65437, 65532, 65465, 65533, 65486, 65533, 65501, 65534, 65511, 65534, 65519, 65534, 65524, 65534, 65528, 65535,
+		65530, 65535, 65532, 65535, 65533, 65535, 65534, 65535, 65534, 65535, 65534, 65535, 65535, 65535, 65535, 65535,
+		65535, 65535, 65535, 65535, 65535, 65535, 65535, 65535, 65535, 65535, 65535, 65535, 65535, 65535, 65535, 65535,
+		65535, 65535, 65535, 65535, 65535, 65535, 65535, 65535, 65535, 65535, 65535, 65535, 65535, 65535, 65535, 65535,
+		65535, 65535, 65535, 65535, 65535, 65535, 65535, 65535, 65535, 65535, 65535, 65535, 65535, 65535, 65535, 65535,
+		65535, 65535, 65535, 65535, 65535, 65535, 65535, 65535, 65535, 65535, 65535, 65535, 65535, 65535, 65535, 65535,
+		65535, 65535, 65535, 65535, 65535, 65535, 65535, 65535, 65535, 65535, 65535, 65535, 65535, 65535, 65535, 65535,
+		65535, 65535, 65535, 65535, 65535, 65535, 65535, 65535, 65535, 65535, 65535, 65535, 65535, 65535, 65535, 65535,
+		65535, 65535, 65535, 65535, 65535, 65535, 65535, 65535, 65535, 65535, 65535, 65535, 65535, 65535, 65535, 65535,
+		65535, 65535, 65535, 65535, 65535, 65535, 65535, 65535, 65535, 65535, 65535, 65535, 0, 0, 0, 83,
+		5878, 138, 9346, 847, 15746, 1826, 19008, 4678, 21591, 8022, 22696, 13055, 23563, 18714, 24093, 25757,
+		24816, 33249, 25580, 39955, 26772, 45195, 28028, 50103, 29751, 53910, 31433, 56973, 33540, 59009, 35561, 60983,
+		37832, 62450, 39986, 63355, 42256, 63888, 44366, 64329, 46513, 64672, 48545, 64889, 50542, 65034, 52376, 65189,
+		54142, 65293, 55752, 65366, 57252, 65404, 58584, 65433, 59782, 65451, 60812, 65464, 61710, 65474, 62474, 65483,
+		63112, 65491, 63640, 65498, 64069, 65504, 64410, 65509, 64680, 65513, 64891, 65517, 65054, 65520, 65178, 65523,
+		65273, 65525, 65345, 65527, 65398, 65529, 65437, 65530, 65466, 65531, 65487, 65532, 65501, 65533, 65512, 65533,
+		65519, 65534, 65525, 65534, 65528, 65534, 65530, 65534, 65532, 65535, 65533, 65535, 65534, 65535, 65534, 65535,
+		65534, 65535, 65535, 65535, 65535, 65535, 65535, 65535, 65535, 65535, 65535, 65535, 65535, 65535, 65535, 65535,
+		65535, 65535, 65535, 65535, 65535, 65535, 65535, 65535, 65535, 65535, 65535, 65535, 65535, 65535, 65535, 65535,
+		65535, 65535, 65535, 65535, 65535, 65535, 65535, 65535, 65535, 65535, 65535, 65535, 65535, 65535, 65535, 65535,
+		65535, 65535, 65535, 65535, 65535, 65535, 65535, 65535, 65535, 65535, 65535, 65535, 65535, 65535, 65535, 65535,
+		65535, 65535, 65535, 65535, 65535, 65535, 65535, 65535, 65535, 65535, 65535, 65535, 65535, 65535, 65535, 65535,
+		65535, 65535, 65535, 65535, 65535, 65535, 65535, 65535, 65535, 65535, 65535, 65535, 65535, 65535, 65535, 65535,
+		65535, 65535, 65535, 65535, 65535, 65535, 65535, 65535, 65535, 65535, 65535, 65535, 65535, 65535, 65535, 65535,
+		65535, 65535, 65535, 65535, 65535, 65535, 65535, 65535, 65535, 65535, 65535, 65535, 65535, 65535, 65535, 65535,
+		0, 0, 0, 68, 4435, 119, 7231, 792, 12216, 1720, 14792, 4457, 16859, 7655, 17733, 12500,
+		18462, 17958, 18930, 24811, 19622, 32119, 20340, 38763, 21480, 44055, 22668, 49051, 24348, 52959, 25990, 56145,
+		28076, 58310, 30097, 60388, 32419, 61946, 34672, 62938, 37110, 63551, 39462, 64054, 41889, 64439, 44259, 64688,
+		46631, 64860, 48860, 65035, 51022, 65154, 53032, 65239, 54907, 65292, 56593, 65336, 58113, 65368, 59433, 65392,
+		60584, 65412, 61565, 65430, 62391, 65446, 63070, 65459, 63624, 65471, 64067, 65481, 64417, 65490, 64690, 65497,
+		64902, 65504, 65065, 65510, 65189, 65514, 65283, 65518, 65353, 65522, 65404, 65524, 65442, 65527, 65470, 65528,
+		65490, 65530, 65504, 65531, 65514, 65532, 65521, 65533, 65525, 65533, 65529, 65534, 65531, 65534, 65532, 65534,
+		65533, 65534, 65534, 65535, 65534, 65535, 65534, 65535, 65535, 65535, 65535, 65535, 65535, 65535, 65535, 65535,
+		65535, 65535, 65535, 65535, 65535, 65535, 65535, 65535, 65535, 65535, 65535, 65535, 65535, 65535, 65535, 65535,
+		65535, 65535, 65535, 65535, 65535, 65535, 65535, 65535, 65535, 65535, 65535, 65535, 65535, 65535, 65535, 65535,
+		65535, 65535, 65535, 65535, 65535, 65535, 65535, 65535, 65535, 65535, 65535, 65535, 65535, 65535, 65535, 65535,
+		65535, 65535, 65535, 65535, 65535, 65535, 65535, 65535, 65535, 65535, 65535, 65535, 65535, 65535, 65535, 65535,
+		65535, 65535, 65535, 65535, 65535, 65535, 65535, 65535, 65535, 65535, 65535, 65535, 65535, 65535, 65535, 65535,
+		65535, 65535, 65535, 65535, 65535, 65535, 65535, 65535, 65535, 65535, 65535, 65535, 65535, 65535, 65535, 65535,
+		65535, 65535, 65535, 65535, 65535, 65535, 65535, 65535, 65535, 65535, 65535, 65535, 65535, 65535, 65535, 65535,
+		65535, 65535, 65535, 65535, 0, 0, 0, 60, 3559, 109, 5591, 740, 9179, 1619, 11084, 4217,
+		12502, 7258, 13180, 11901, 13734, 17156, 14135, 23792, 14767, 30905, 15432, 37507, 16484, 42867, 17592, 47943,
+		19174, 51948, 20804, 55242, 22825, 57542, 24852, 59719, 27224, 61362, 29563, 62438, 32146, 63128, 34712, 63690,
+		37411, 64114, 40092, 64398, 42806, 64601, 45410, 64801, 47951, 64940, 50339, 65047, 52581, 65122, 54612, 65185,
+		56453, 65234, 58060, 65274, 59462, 65309, 60657, 65340, 61667, 65367, 62497, 65391, 63175, 65413, 63718, 65431,
+		64148, 65448, 64485, 65462, 64748, 65474, 64949, 65485, 65103, 65494, 65219, 65502, 65306, 65508, 65370, 65513,
+		65418, 65518, 65452, 65521, 65477, 65524, 65495, 65527, 65508, 65529, 65516, 65530, 65522, 65531, 65526, 65532,
+		65529, 65533, 65531, 65533, 65532, 65534, 65533, 65534, 65534, 65534, 65534, 65535, 65534, 65535, 65535, 65535,
+		65535, 65535, 65535, 65535, 65535, 65535, 65535, 65535, 65535, 65535, 65535, 65535, 65535, 65535, 65535, 65535,
+		65535, 65535, 65535, 65535, 65535, 65535, 65535, 65535, 65535, 65535, 65535, 65535, 65535, 65535, 65535, 65535,
+		65535, 65535, 65535, 65535, 65535, 65535, 65535, 65535, 65535, 65535, 65535, 65535, 65535, 65535, 65535, 65535,
+		65535, 65535, 65535, 65535, 65535, 65535, 65535, 65535, 65535, 65535, 65535, 65535, 65535, 65535, 65535, 65535,
+		65535, 65535, 65535, 65535, 65535, 65535, 65535, 65535, 65535, 65535, 65535, 65535, 65535, 65535, 65535, 65535,
+		65535, 65535, 65535, 65535, 65535, 65535, 65535, 65535, 65535, 65535, 65535, 65535, 65535, 65535, 65535, 65535,
+		65535, 65535, 65535, 65535, 65535, 65535, 65535, 65535, 65535, 65535, 65535, 65535, 65535, 65535, 65535, 65535,
+		65535, 65535, 65535, 65535, 65535, 65535, 65535, 65535, 0, 0, 0, 55, 2491, 102, 3908, 686,
+		6562, 1502, 7948, 3933, 9052, 6759, 9602, 11145, 10094, 16114, 10452, 22453, 11029, 29295, 11625, 35784,
+		12576, 41187, 13587, 46361, 15023, 50512, 16564, 53963, 18467, 56441, 20421, 58746, 22749, 60512, 25113, 61698,
+		27765, 62488, 30460, 63130, 33360, 63609, 36286, 63939, 39283, 64186, 42205, 64423, 45083, 64594, 47814, 64734,
+		50387, 64840, 52734, 64933, 54869, 65011, 56741, 65077, 58381, 65135, 59779, 65187, 60960, 65235, 61934, 65276,
+		62731, 65314, 63371, 65347, 63880, 65376, 64278, 65402, 64590, 65424, 64829, 65443, 65013, 65459, 65152, 65473,
+		65256, 65485, 65334, 65495, 65391, 65503, 65433, 65510, 65463, 65515, 65484, 65519, 65500, 65523, 65510, 65526,
+		65518, 65528, 65523, 65530, 65527, 65531, 65529, 65532, 65531, 65533, 65532, 65533, 65533, 65534, 65533, 65534,
+		65534, 65534, 65534, 65535, 65534, 65535, 65534, 65535, 65535, 65535, 65535, 65535, 65535, 65535, 65535, 65535,
+		65535, 65535, 65535, 65535, 65535, 65535, 65535, 65535, 65535, 65535, 65535, 65535, 65535, 65535, 65535, 65535,
+		65535, 65535, 65535, 65535, 65535, 65535, 65535, 65535, 65535, 65535, 65535, 65535, 65535, 65535, 65535, 65535,
+		65535, 65535, 65535, 65535, 65535, 65535, 65535, 65535, 65535, 65535, 65535, 65535, 65535, 65535, 65535, 65535,
+		65535, 65535, 65535, 65535, 65535, 65535, 65535, 65535, 65535, 65535, 65535, 65535, 65535, 65535, 65535, 65535,
+		65535, 65535, 65535, 65535, 65535, 65535, 65535, 65535, 65535, 65535, 65535, 65535, 65535, 65535, 65535, 65535,
+		65535, 65535, 65535, 65535, 65535, 65535, 65535, 65535, 65535, 65535, 65535, 65535, 65535, 65535, 65535, 65535,
+		65535, 65535, 65535, 65535, 65535, 65535, 65535, 65535, 65535, 65535, 65535, 65535, 0, 0, 0, 47,
+		1773, 93, 2810, 623, 4711, 1374, 5703, 3607, 6477, 6252, 6880, 10365, 7250, 15064, 7547, 21104,
+		8047, 27695, 8562, 34102, 9390, 39545, 10293, 44731, 11572, 48971, 12959, 52576, 14720, 55220, 16592, 57631,
+		18858, 59494, 21227, 60782, 23927, 61677, 26738, 62389, 29787, 62924, 32920, 63310, 36167, 63606, 39368, 63885,
+		42539, 64096, 45567, 64279, 48426, 64429, 51050, 64562, 53441, 64678, 55548, 64779, 57396, 64871, 58974, 64954,
+		60307, 65030, 61411, 65098, 62317, 65159, 63045, 65214, 63625, 65262, 64081, 65305, 64438, 65342, 64713, 65375,
+		64924, 65403, 65084, 65427, 65205, 65447, 65295, 65464, 65362, 65478, 65411, 65490, 65446, 65499, 65471, 65507,
+		65490, 65513, 65503, 65518, 65512, 65522, 65518, 65525, 65523, 65528, 65526, 65530, 65528, 65531, 65530, 65532,
+		65531, 65533, 65532, 65533, 65533, 65534, 65533, 65534, 65533, 65534, 65534, 65535, 65534, 65535, 65534, 65535,
+		65534, 65535, 65535, 65535, 65535, 65535, 65535, 65535, 65535, 65535, 65535, 65535, 65535, 65535, 65535, 65535,
+		65535, 65535, 65535, 65535, 65535, 65535, 65535, 65535, 65535, 65535, 65535, 65535, 65535, 65535, 65535, 65535,
+		65535, 65535, 65535, 65535, 65535, 65535, 65535, 65535, 65535, 65535, 65535, 65535, 65535, 65535, 65535, 65535,
+		65535, 65535, 65535, 65535, 65535, 65535, 65535, 65535, 65535, 65535, 65535, 65535, 65535, 65535, 65535, 65535,
+		65535, 65535, 65535, 65535, 65535, 65535, 65535, 65535, 65535, 65535, 65535, 65535, 65535, 65535, 65535, 65535,
+		65535, 65535, 65535, 65535, 65535, 65535, 65535, 65535, 65535, 65535, 65535, 65535, 65535, 65535, 65535, 65535,
+		65535, 65535, 65535, 65535, 65535, 65535, 65535, 65535, 65535, 65535, 65535, 65535, 65535, 65535, 65535, 65535,
+		0, 0, 0, 37, 1231, 80, 2037, 542, 3443, 1221, 4207, 3239, 4813, 5649, 5138, 9457,
+		5436, 13832, 5680, 19511, 6100, 25777, 6526, 31995, 7225, 37459, 7993, 42710, 9094, 47068, 10315, 50815,
+		11896, 53639, 13645, 56174, 15790, 58147, 18104, 59545, 20795, 60553, 23663, 61346, 26806, 61952, 30087, 62405,
+		33519, 62763, 36940, 63100, 40340, 63365, 43610, 63607, 46706, 63817, 49559, 64008, 52166, 64179, 54471, 64333,
+		56496, 64473, 58230, 64602, 59699, 64720, 60917, 64827, 61920, 64923, 62727, 65010, 63373, 65088, 63882, 65157,
+		64281, 65217, 64590, 65270, 64828, 65316, 65009, 65355, 65146, 65388, 65249, 65416, 65325, 65440, 65382, 65459,
+		65423, 65475, 65453, 65488, 65475, 65498, 65490, 65507, 65502, 65513, 65510, 65519, 65516, 65523, 65520, 65526,
+		65523, 65528, 65526, 65530, 65528, 65531, 65529, 65532, 65530, 65533, 65531, 65534, 65532, 65534, 65533, 65534,
+		65533, 65535, 65533, 65535, 65534, 65535, 65534, 65535, 65534, 65535, 65534, 65535, 65534, 65535, 65535, 65535,
+		65535, 65535, 65535, 65535, 65535, 65535, 65535, 65535, 65535, 65535, 65535, 65535, 65535, 65535, 65535, 65535,
+		65535, 65535, 65535, 65535, 65535, 65535, 65535, 65535, 65535, 65535, 65535, 65535, 65535, 65535, 65535, 65535,
+		65535, 65535, 65535, 65535, 65535, 65535, 65535, 65535, 65535, 65535, 65535, 65535, 65535, 65535, 65535, 65535,
+		65535, 65535, 65535, 65535, 65535, 65535, 65535, 65535, 65535, 65535, 65535, 65535, 65535, 65535, 65535, 65535,
+		65535, 65535, 65535, 65535, 65535, 65535, 65535, 65535, 65535, 65535, 65535, 65535, 65535, 65535, 65535, 65535,
+		65535, 65535, 65535, 65535, 65535, 65535, 65535, 65535, 65535, 65535, 65535, 65535, 65535, 65535, 65535, 65535,
+		65535, 65535, 65535, 65535, 0, 0, 0, 31, 878, 73, 1469, 468, 2355, 1085, 2879, 2888,
+		3235, 5090, 3455, 8583, 3659, 12659, 3852, 18009, 4192, 23955, 4552, 29974, 5147, 35438, 5816, 40683,
+		6789, 45114, 7904, 48943, 9361, 51890, 11030, 54505, 13095, 56557, 15405, 58042, 18116, 59152, 21038, 60030,
+		24283, 60707, 27696, 61231, 31275, 61659, 34872, 62062, 38465, 62396, 41928, 62709, 45220, 62990, 48261, 63252,
+		51048, 63492, 53514, 63713, 55690, 63917, 57556, 64106, 59141, 64280, 60457, 64440, 61542, 64585, 62420, 64717,
+		63125, 64836, 63681, 64941, 64120, 65035, 64459, 65117, 64722, 65188, 64924, 65249, 65077, 65301, 65192, 65346,
+		65279, 65383, 65343, 65413, 65391, 65439, 65426, 65459, 65452, 65476, 65471, 65489, 65486, 65500, 65496, 65508,
+		65504, 65515, 65510, 65520, 65515, 65524, 65519, 65527, 65522, 65529, 65524, 65531, 65526, 65532, 65528, 65533,
+		65529, 65533, 65530, 65534, 65531, 65534, 65532, 65534, 65532, 65535, 65533, 65535, 65533, 65535, 65534, 65535,
+		65534, 65535, 65534, 65535, 65534, 65535, 65535, 65535, 65535, 65535, 65535, 65535, 65535, 65535, 65535, 65535,
+		65535, 65535, 65535, 65535, 65535, 65535, 65535, 65535, 65535, 65535, 65535, 65535, 65535, 65535, 65535, 65535,
+		65535, 65535, 65535, 65535, 65535, 65535, 65535, 65535, 65535, 65535, 65535, 65535, 65535, 65535, 65535, 65535,
+		65535, 65535, 65535, 65535, 65535, 65535, 65535, 65535, 65535, 65535, 65535, 65535, 65535, 65535, 65535, 65535,
+		65535, 65535, 65535, 65535, 65535, 65535, 65535, 65535, 65535, 65535, 65535, 65535, 65535, 65535, 65535, 65535,
+		65535, 65535, 65535, 65535, 65535, 65535, 65535, 65535, 65535, 65535, 65535, 65535, 65535, 65535, 65535, 65535,
+		65535, 65535, 65535, 65535, 65535, 65535, 65535, 65535, 0, 0, 0, 27, 564, 64, 929, 393,
+		1515, 941, 1819, 2524, 2057, 4501, 2197, 7627, 2348, 11358, 2498, 16319, 2783, 21904, 3085, 27703,
+		3589, 33116, 4173, 38311, 5023, 42786, 6041, 46677, 7388, 49745, 8978, 52440, 10968, 54567, 13244, 56147,
+		15933, 57355, 18881, 58311, 22182, 59070, 25680, 59675, 29359, 60187, 33080, 60673, 36821, 61094, 40436, 61498,
+		43881, 61871, 47075, 62226, 50007, 62556, 52608, 62865, 54911, 63154, 56890, 63423, 58577, 63674, 59982, 63905,
+		61145, 64117, 62088, 64310, 62848, 64485, 63452, 64641, 63929, 64780, 64300, 64902, 64590, 65009, 64812, 65101,
+		64984, 65179, 65113, 65246, 65212, 65302, 65286, 65349, 65342, 65388, 65384, 65419, 65416, 65445, 65441, 65465,
+		65459, 65481, 65474, 65494, 65485, 65504, 65494, 65512, 65501, 65518, 65507, 65522, 65512, 65526, 65516, 65528,
+		65519, 65530, 65522, 65531, 65524, 65532, 65526, 65533, 65528, 65534, 65529, 65534, 65530, 65534, 65531, 65535,
+		65532, 65535, 65533, 65535, 65533, 65535, 65533, 65535, 65534, 65535, 65534, 65535, 65534, 65535, 65534, 65535,
+		65535, 65535, 65535, 65535, 65535, 65535, 65535, 65535, 65535, 65535, 65535, 65535, 65535, 65535, 65535, 65535,
+		65535, 65535, 65535, 65535, 65535, 65535, 65535, 65535, 65535, 65535, 65535, 65535, 65535, 65535, 65535, 65535,
+		65535, 65535, 65535, 65535, 65535, 65535, 65535, 65535, 65535, 65535, 65535, 65535, 65535, 65535, 65535, 65535,
+		65535, 65535, 65535, 65535, 65535, 65535, 65535, 65535, 65535, 65535, 65535, 65535, 65535, 65535, 65535, 65535,
+		65535, 65535, 65535, 65535, 65535, 65535, 65535, 65535, 65535, 65535, 65535, 65535, 65535, 65535, 65535, 65535,
+		65535, 65535, 65535, 65535, 65535, 65535, 65535, 65535, 65535, 65535, 65535, 65535, 0, 0, 0, 23,
+		353, 58, 627, 329, 1012, 810, 1241, 2175, 1383, 3954, 1482, 6777, 1592, 10212, 1707, 14794,
+		1932, 20024, 2180, 25549, 2597, 30863, 3097, 35972, 3837, 40422, 4753, 44308, 5990, 47431, 7493, 50130,
+		9388, 52291, 11605, 53930, 14250, 55213, 17197, 56246, 20506, 57083, 24040, 57772, 27775, 58374, 31575, 58947,
+		35408, 59468, 39125, 59975, 42684, 60454, 45989, 60916, 49030, 61354, 51743, 61769, 54150, 62162, 56225, 62531,
+		58001, 62877, 59486, 63199, 60719, 63496, 61724, 63768, 62537, 64015, 63185, 64238, 63701, 64437, 64104, 64612,
+		64421, 64766, 64667, 64899, 64857, 65014, 65004, 65111, 65116, 65193, 65203, 65261, 65270, 65318, 65321, 65364,
+		65361, 65401, 65393, 65431, 65418, 65455, 65437, 65474, 65454, 65489, 65467, 65500, 65478, 65509, 65487, 65516,
+		65495, 65521, 65502, 65525, 65507, 65527, 65512, 65530, 65516, 65531, 65519, 65532, 65522, 65533, 65525, 65534,
+		65526, 65534, 65528, 65534, 65529, 65535, 65531, 65535, 65532, 65535, 65532, 65535, 65533, 65535, 65533, 65535,
+		65534, 65535, 65534, 65535, 65534, 65535, 65534, 65535, 65535, 65535, 65535, 65535, 65535, 65535, 65535, 65535,
+		65535, 65535, 65535, 65535, 65535, 65535, 65535, 65535, 65535, 65535, 65535, 65535, 65535, 65535, 65535, 65535,
+		65535, 65535, 65535, 65535, 65535, 65535, 65535, 65535, 65535, 65535, 65535, 65535, 65535, 65535, 65535, 65535,
+		65535, 65535, 65535, 65535, 65535, 65535, 65535, 65535, 65535, 65535, 65535, 65535, 65535, 65535, 65535, 65535,
+		65535, 65535, 65535, 65535, 65535, 65535, 65535, 65535, 65535, 65535, 65535, 65535, 65535, 65535, 65535, 65535,
+		65535, 65535, 65535, 65535, 65535, 65535, 65535, 65535, 65535, 65535, 65535, 65535, 65535, 65535, 65535, 65535,
+		0, 0, 0, 20, 248, 50, 471, 267, 746, 679, 923, 1833, 1032, 3408, 1102, 5897,
+		1184, 9024, 1273, 13205, 1446, 18060, 1647, 23279, 1984, 28425, 2407, 33389, 3047, 37770, 3858, 41608,
+		4982, 44740, 6383, 47419, 8180, 49602, 10322, 51285, 12903, 52632, 15812, 53741, 19098, 54662, 22634, 55441,
+		26389, 56141, 30230, 56819, 34114, 57454, 37897, 58077, 41535, 58680, 44926, 59270, 48055, 59838, 50860, 60383,
+		53355, 60902, 55514, 61395, 57368, 61860, 58927, 62295, 60227, 62699, 61291, 63072, 62157, 63411, 62852, 63719,
+		63408, 63994, 63847, 64238, 64194, 64453, 64466, 64639, 64680, 64799, 64847, 64936, 64978, 65051, 65080, 65147,
+		65161, 65227, 65225, 65292, 65277, 65345, 65319, 65387, 65353, 65421, 65381, 65448, 65405, 65469, 65425, 65485,
+		65442, 65498, 65456, 65507, 65468, 65515, 65479, 65520, 65488, 65524, 65496, 65527, 65503, 65529, 65508, 65531,
+		65513, 65532, 65517, 65533, 65520, 65534, 65523, 65534, 65526, 65534, 65527, 65535, 65529, 65535, 65530, 65535,
+		65531, 65535, 65532, 65535, 65533, 65535, 65533, 65535, 65534, 65535, 65534, 65535, 65534, 65535, 65534, 65535,
+		65535, 65535, 65535, 65535, 65535, 65535, 65535, 65535, 65535, 65535, 65535, 65535, 65535, 65535, 65535, 65535,
+		65535, 65535, 65535, 65535, 65535, 65535, 65535, 65535, 65535, 65535, 65535, 65535, 65535, 65535, 65535, 65535,
+		65535, 65535, 65535, 65535, 65535, 65535, 65535, 65535, 65535, 65535, 65535, 65535, 65535, 65535, 65535, 65535,
+		65535, 65535, 65535, 65535, 65535, 65535, 65535, 65535, 65535, 65535, 65535, 65535, 65535, 65535, 65535, 65535,
+		65535, 65535, 65535, 65535, 65535, 65535, 65535, 65535, 65535, 65535, 65535, 65535, 65535, 65535, 65535, 65535,
+		65535, 65535, 65535, 65535, 0, 0, 0, 17, 184, 43, 343, 222, 535, 568, 672, 1545,
+		736, 2936, 788, 5126, 845, 7989, 913, 11797, 1044, 16266, 1206, 21169, 1481, 26073, 1845, 30823,
+		2405, 35075, 3146, 38797, 4189, 41872, 5505, 44497, 7224, 46654, 9289, 48338, 11794, 49723, 14652, 50883,
+		17895, 51873, 21404, 52740, 25152, 53539, 29000, 54320, 32906, 55077, 36724, 55831, 40413, 56573, 43865, 57305,
+		47060, 58018, 49936, 58707, 52505, 59372, 54737, 60008, 56663, 60613, 58289, 61183, 59653, 61715, 60774, 62208,
+		61692, 62660, 62434, 63071, 63033, 63441, 63510, 63770, 63891, 64059, 64194, 64312, 64436, 64529, 64627, 64715,
+		64781, 64872, 64904, 65003, 65004, 65112, 65085, 65201, 65153, 65273, 65209, 65331, 65256, 65378, 65296, 65414,
+		65331, 65443, 65360, 65466, 65386, 65483, 65408, 65496, 65428, 65506, 65444, 65514, 65459, 65520, 65471, 65524,
+		65482, 65527, 65491, 65529, 65499, 65531, 65505, 65532, 65511, 65533, 65515, 65534, 65519, 65534, 65522, 65534,
+		65525, 65534, 65527, 65535, 65529, 65535, 65530, 65535, 65531, 65535, 65532, 65535, 65533, 65535, 65533, 65535,
+		65534, 65535, 65534, 65535, 65534, 65535, 65534, 65535, 65535, 65535, 65535, 65535, 65535, 65535, 65535, 65535,
+		65535, 65535, 65535, 65535, 65535, 65535, 65535, 65535, 65535, 65535, 65535, 65535, 65535, 65535, 65535, 65535,
+		65535, 65535, 65535, 65535, 65535, 65535, 65535, 65535, 65535, 65535, 65535, 65535, 65535, 65535, 65535, 65535,
+		65535, 65535, 65535, 65535, 65535, 65535, 65535, 65535, 65535, 65535, 65535, 65535, 65535, 65535, 65535, 65535,
+		65535, 65535, 65535, 65535, 65535, 65535, 65535, 65535, 65535, 65535, 65535, 65535, 65535, 65535, 65535, 65535,
+		65535, 65535, 65535, 65535, 65535, 65535, 65535, 65535, 0, 0, 0, 14, 128, 35, 226, 183,
+		343, 474, 446, 1274, 493, 2493, 528, 4396, 572, 6972, 624, 10399, 727, 14482, 863, 19022,
+		1096, 23646, 1421, 28124, 1926, 32175, 2611, 35748, 3583, 38729, 4828, 41252, 6465, 43353, 8452, 45029,
+		10873, 46437, 13662, 47640, 16839, 48696, 20295, 49647, 24007, 50548, 27831, 51441, 31729, 52326, 35554, 53216,
+		39267, 54107, 42756, 54995, 45998, 55870, 48929, 56725, 51558, 57557, 53854, 58358, 55844, 59125, 57533, 59853,
+		58957, 60536, 60136, 61172, 61108, 61758, 61901, 62292, 62546, 62774, 63065, 63204, 63486, 63584, 63825, 63915,
+		64100, 64202, 64322, 64447, 64505, 64654, 64655, 64828, 64780, 64971, 64885, 65089, 64974, 65185, 65050, 65263,
+		65116, 65324, 65173, 65373, 65222, 65412, 65266, 65442, 65304, 65465, 65338, 65483, 65367, 65496, 65393, 65506,
+		65415, 65514, 65434, 65520, 65451, 65524, 65465, 65527, 65478, 65529, 65488, 65531, 65497, 65532, 65504, 65533,
+		65510, 65533, 65515, 65534, 65519, 65534, 65522, 65534, 65525, 65535, 65527, 65535, 65529, 65535, 65530, 65535,
+		65531, 65535, 65532, 65535, 65533, 65535, 65533, 65535, 65534, 65535, 65534, 65535, 65534, 65535, 65535, 65535,
+		65535, 65535, 65535, 65535, 65535, 65535, 65535, 65535, 65535, 65535, 65535, 65535, 65535, 65535, 65535, 65535,
+		65535, 65535, 65535, 65535, 65535, 65535, 65535, 65535, 65535, 65535, 65535, 65535, 65535, 65535, 65535, 65535,
+		65535, 65535, 65535, 65535, 65535, 65535, 65535, 65535, 65535, 65535, 65535, 65535, 65535, 65535, 65535, 65535,
+		65535, 65535, 65535, 65535, 65535, 65535, 65535, 65535, 65535, 65535, 65535, 65535, 65535, 65535, 65535, 65535,
+		65535, 65535, 65535, 65535, 65535, 65535, 65535, 65535, 65535, 65535, 65535, 65535, 0, 0, 0, 10,
+		81, 28, 146, 148, 226, 391, 315, 1045, 346, 2104, 375, 3763, 410, 6074, 450, 9130,
+		533, 12835, 646, 16990, 847, 21276, 1137, 25443, 1596, 29249, 2225, 32615, 3130, 35448, 4304, 37839,
+		5860, 39855, 7763, 41495, 10094, 42901, 12795, 44131, 15888, 45240, 19270, 46265, 22917, 47261, 26692, 48262,
+		30553, 49274, 34362, 50302, 38074, 51346, 41577, 52394, 44848, 53439, 47818, 54471, 50493, 55482, 52842, 56465,
+		54889, 57412, 56636, 58315, 58118, 59168, 59354, 59966, 60381, 60705, 61226, 61380, 61921, 61992, 62488, 62540,
+		62953, 63024, 63335, 63448, 63650, 63815, 63911, 64130, 64130, 64396, 64314, 64619, 64472, 64804, 64607, 64956,
+		64725, 65080, 64827, 65180, 64918, 65260, 64998, 65324, 65069, 65373, 65132, 65412, 65187, 65443, 65237, 65466,
+		65280, 65483, 65319, 65497, 65352, 65507, 65381, 65514, 65407, 65520, 65428, 65524, 65447, 65527, 65462, 65529,
+		65476, 65531, 65487, 65532, 65496, 65533, 65504, 65533, 65510, 65534, 65515, 65534, 65519, 65534, 65523, 65534,
+		65525, 65535, 65528, 65535, 65529, 65535, 65531, 65535, 65532, 65535, 65532, 65535, 65533, 65535, 65534, 65535,
+		65534, 65535, 65534, 65535, 65534, 65535, 65535, 65535, 65535, 65535, 65535, 65535, 65535, 65535, 65535, 65535,
+		65535, 65535, 65535, 65535, 65535, 65535, 65535, 65535, 65535, 65535, 65535, 65535, 65535, 65535, 65535, 65535,
+		65535, 65535, 65535, 65535, 65535, 65535, 65535, 65535, 65535, 65535, 65535, 65535, 65535, 65535, 65535, 65535,
+		65535, 65535, 65535, 65535, 65535, 65535, 65535, 65535, 65535, 65535, 65535, 65535, 65535, 65535, 65535, 65535,
+		65535, 65535, 65535, 65535, 65535, 65535, 65535, 65535, 65535, 65535, 65535, 65535, 65535, 65535, 65535, 65535,
+		0, 0, 0, 8, 50, 22, 107, 116, 169, 318, 236, 846, 256, 1758, 280, 3179,
+		307, 5226, 337, 7935, 405, 11254, 501, 14993, 675, 18904, 934, 22729, 1349, 26252, 1929, 29368,
+		2772, 32016, 3876, 34260, 5348, 36176, 7161, 37760, 9394, 39146, 11996, 40383, 14987, 41528, 18274, 42618,
+		21835, 43700, 25537, 44806, 29339, 45942, 33107, 47108, 36794, 48306, 40292, 49521, 43571, 50744, 46566, 51961,
+		49275, 53166, 51666, 54344, 53762, 55487, 55562, 56584, 57100, 57625, 58392, 58604, 59477, 59513, 60377, 60347,
+		61126, 61104, 61746, 61784, 62263, 62387, 62694, 62915, 63058, 63373, 63366, 63766, 63630, 64099, 63858, 64379,
+		64056, 64611, 64231, 64802, 64386, 64958, 64524, 65084, 64648, 65185, 64759, 65265, 64858, 65328, 64947, 65378,
+		65026, 65416, 65097, 65445, 65160, 65468, 65216, 65485, 65265, 65498, 65307, 65508, 65344, 65515, 65376, 65520,
+		65404, 65524, 65427, 65527, 65447, 65529, 65463, 65531, 65477, 65532, 65488, 65532, 65498, 65533, 65505, 65534,
+		65512, 65534, 65517, 65534, 65521, 65534, 65524, 65534, 65526, 65535, 65528, 65535, 65530, 65535, 65531, 65535,
+		65532, 65535, 65533, 65535, 65533, 65535, 65534, 65535, 65534, 65535, 65534, 65535, 65535, 65535, 65535, 65535,
+		65535, 65535, 65535, 65535, 65535, 65535, 65535, 65535, 65535, 65535, 65535, 65535, 65535, 65535, 65535, 65535,
+		65535, 65535, 65535, 65535, 65535, 65535, 65535, 65535, 65535, 65535, 65535, 65535, 65535, 65535, 65535, 65535,
+		65535, 65535, 65535, 65535, 65535, 65535, 65535, 65535, 65535, 65535, 65535, 65535, 65535, 65535, 65535, 65535,
+		65535, 65535, 65535, 65535, 65535, 65535, 65535, 65535, 65535, 65535, 65535, 65535, 65535, 65535, 65535, 65535,
+		65535, 65535, 65535, 65535, 0, 0, 0, 7, 39, 18, 72, 89, 110, 257, 135, 689,
+		148, 1474, 162, 2700, 181, 4502, 206, 6876, 261, 9818, 346, 13159, 500, 16675, 734, 20125,
+		1112, 23330, 1650, 26167, 2435, 28595, 3473, 30663, 4862, 32455, 6588, 33960, 8716, 35305, 11215, 36535,
+		14094, 37704, 17273, 38843, 20729, 39998, 24341, 41194, 28064, 42442, 31773, 43736, 35416, 45081, 38887, 46457,
+		42158, 47856, 45160, 49261, 47889, 50662, 50312, 52043, 52448, 53391, 54295, 54692, 55884, 55934, 57231, 57105,
+		58371, 58198, 59329, 59204, 60137, 60120, 60815, 60944, 61390, 61677, 61879, 62320, 62300, 62879, 62663, 63358,
+		62982, 63766, 63263, 64108, 63513, 64393, 63738, 64627, 63940, 64819, 64124, 64974, 64290, 65098, 64440, 65197,
+		64576, 65275, 64700, 65336, 64810, 65384, 64910, 65421, 64998, 65449, 65077, 65470, 65146, 65487, 65207, 65499,
+		65260, 65508, 65306, 65515, 65345, 65520, 65378, 65524, 65407, 65526, 65431, 65528, 65450, 65530, 65467, 65531,
+		65481, 65532, 65492, 65533, 65501, 65533, 65508, 65534, 65514, 65534, 65519, 65534, 65522, 65534, 65525, 65534,
+		65528, 65535, 65529, 65535, 65531, 65535, 65532, 65535, 65533, 65535, 65533, 65535, 65534, 65535, 65534, 65535,
+		65534, 65535, 65534, 65535, 65535, 65535, 65535, 65535, 65535, 65535, 65535, 65535, 65535, 65535, 65535, 65535,
+		65535, 65535, 65535, 65535, 65535, 65535, 65535, 65535, 65535, 65535, 65535, 65535, 65535, 65535, 65535, 65535,
+		65535, 65535, 65535, 65535, 65535, 65535, 65535, 65535, 65535, 65535, 65535, 65535, 65535, 65535, 65535, 65535,
+		65535, 65535, 65535, 65535, 65535, 65535, 65535, 65535, 65535, 65535, 65535, 65535, 65535, 65535, 65535, 65535,
+		65535, 65535, 65535, 65535, 65535, 65535, 65535, 65535, 0, 0, 0, 5, 18, 14, 29, 68,
+		53, 205, 63, 553, 72, 1221, 83, 2260, 97, 3827, 119, 5886, 166, 8457, 242, 11393,
+		381, 14513, 595, 17584, 941, 20452, 1439, 22996, 2170, 25195, 3139, 27070, 4441, 28714, 6067, 30127,
+		8081, 31421, 10458, 32629, 13209, 33806, 16261, 34982, 19593, 36196, 23093, 37471, 26716, 38819, 30342, 40233,
+		33919, 41717, 37346, 43249, 40590, 44821, 43582, 46413, 46316, 48013, 48757, 49601, 50922, 51161, 52808, 52674,
+		54442, 54125, 55841, 55500, 57037, 56786, 58055, 57974, 58924, 59059, 59665, 60036, 60305, 60907, 60860, 61673,
+		61347, 62339, 61777, 62912, 62161, 63399, 62507, 63809, 62820, 64151, 63106, 64433, 63368, 64663, 63607, 64850,
+		63827, 65000, 64028, 65120, 64211, 65214, 64378, 65289, 64529, 65347, 64665, 65391, 64787, 65426, 64895, 65452,
+		64991, 65472, 65076, 65488, 65149, 65499, 65213, 65508, 65268, 65514, 65314, 65519, 65354, 65523, 65388, 65525,
+		65416, 65528, 65439, 65529, 65458, 65530, 65474, 65531, 65486, 65532, 65497, 65533, 65505, 65533, 65512, 65533,
+		65517, 65534, 65521, 65534, 65524, 65534, 65527, 65534, 65529, 65534, 65530, 65535, 65532, 65535, 65532, 65535,
+		65533, 65535, 65534, 65535, 65534, 65535, 65534, 65535, 65534, 65535, 65535, 65535, 65535, 65535, 65535, 65535,
+		65535, 65535, 65535, 65535, 65535, 65535, 65535, 65535, 65535, 65535, 65535, 65535, 65535, 65535, 65535, 65535,
+		65535, 65535, 65535, 65535, 65535, 65535, 65535, 65535, 65535, 65535, 65535, 65535, 65535, 65535, 65535, 65535,
+		65535, 65535, 65535, 65535, 65535, 65535, 65535, 65535, 65535, 65535, 65535, 65535, 65535, 65535, 65535, 65535,
+		65535, 65535, 65535, 65535, 65535, 65535, 65535, 65535, 65535, 65535, 65535, 65535, 0, 0, 0, 5,
+		10, 12, 10, 49, 19, 164, 27, 445, 32, 1012, 38, 1894, 47, 3244, 65, 5014,
+		104, 7238, 169, 9783, 296, 12503, 488, 15196, 801, 17721, 1256, 19968, 1928, 21925, 2826, 23607,
+		4037, 25099, 5560, 26410, 7458, 27638, 9704, 28811, 12319, 29981, 15233, 31173, 18427, 32428, 21799, 33762,
+		25302, 35193, 28825, 36710, 32316, 38316, 35678, 39990, 38872, 41724, 41836, 43495, 44556, 45288, 47001, 47079,
+		49181, 48849, 51095, 50574, 52767, 52237, 54212, 53818, 55461, 55302, 56538, 56678, 57471, 57936, 58281, 59073,
+		58992, 60088, 59620, 60982, 60182, 61761, 60688, 62432, 61149, 63003, 61571, 63485, 61959, 63887, 62319, 64219,
+		62652, 64491, 62960, 64711, 63245, 64889, 63508, 65031, 63750, 65144, 63971, 65232, 64172, 65302, 64354, 65356,
+		64518, 65397, 64664, 65429, 64793, 65454, 64907, 65473, 65007, 65487, 65094, 65498, 65168, 65506, 65232, 65513,
+		65286, 65517, 65332, 65521, 65370, 65524, 65402, 65526, 65429, 65528, 65450, 65529, 65468, 65530, 65482, 65531,
+		65494, 65532, 65503, 65532, 65510, 65533, 65516, 65533, 65520, 65534, 65524, 65534, 65526, 65534, 65529, 65534,
+		65530, 65534, 65531, 65535, 65532, 65535, 65533, 65535, 65534, 65535, 65534, 65535, 65534, 65535, 65534, 65535,
+		65535, 65535, 65535, 65535, 65535, 65535, 65535, 65535, 65535, 65535, 65535, 65535, 65535, 65535, 65535, 65535,
+		65535, 65535, 65535, 65535, 65535, 65535, 65535, 65535, 65535, 65535, 65535, 65535, 65535, 65535, 65535, 65535,
+		65535, 65535, 65535, 65535, 65535, 65535, 65535, 65535, 65535, 65535, 65535, 65535, 65535, 65535, 65535, 65535,
+		65535, 65535, 65535, 65535, 65535, 65535, 65535, 65535, 65535, 65535, 65535, 65535, 65535, 65535, 65535, 65535,
+		0, 0, 0, 4, 5, 10, 5, 38, 11, 134, 15, 358, 18, 837, 21, 1570,
+		29, 2714, 43, 4213, 75, 6111, 131, 8283, 243, 10628, 413, 12954, 695, 15148, 1106, 17109,
+		1718, 18829, 2545, 20315, 3664, 21654, 5081, 22851, 6855, 24000, 8962, 25122, 11428, 26268, 14189, 27461,
+		17229, 28741, 20454, 30119, 23818, 31616, 27219, 33219, 30602, 34933, 33875, 36737, 37000, 38621, 39914, 40560,
+		42602, 42538, 45033, 44525, 47215, 46501, 49145, 48435, 50846, 50308, 52331, 52094, 53630, 53777, 54765, 55340,
+		55763, 56775, 56644, 58073, 57432, 59233, 58141, 60258, 58787, 61152, 59379, 61924, 59928, 62582, 60438, 63137,
+		60914, 63601, 61360, 63986, 61778, 64301, 62168, 64557, 62532, 64765, 62870, 64930, 63182, 65062, 63469, 65166,
+		63731, 65248, 63969, 65312, 64184, 65362, 64376, 65401, 64547, 65430, 64698, 65453, 64830, 65471, 64945, 65484,
+		65044, 65495, 65129, 65503, 65201, 65509, 65263, 65514, 65314, 65518, 65356, 65521, 65392, 65524, 65421, 65526,
+		65444, 65528, 65463, 65529, 65479, 65530, 65491, 65531, 65501, 65532, 65509, 65532, 65515, 65533, 65520, 65533,
+		65523, 65534, 65526, 65534, 65528, 65534, 65530, 65534, 65531, 65534, 65532, 65535, 65533, 65535, 65533, 65535,
+		65534, 65535, 65534, 65535, 65534, 65535, 65535, 65535, 65535, 65535, 65535, 65535, 65535, 65535, 65535, 65535,
+		65535, 65535, 65535, 65535, 65535, 65535, 65535, 65535, 65535, 65535, 65535, 65535, 65535, 65535, 65535, 65535,
+		65535, 65535, 65535, 65535, 65535, 65535, 65535, 65535, 65535, 65535, 65535, 65535, 65535, 65535, 65535, 65535,
+		65535, 65535, 65535, 65535, 65535, 65535, 65535, 65535, 65535, 65535, 65535, 65535, 65535, 65535, 65535, 65535,
+		65535, 65535, 65535, 65535, 0, 0, 0, 3, 3, 8, 3, 27, 5, 105, 5, 285,
+		6, 687, 8, 1298, 14, 2261, 26, 3515, 54, 5119, 102, 6951, 199, 8942, 350, 10923,
+		602, 12801, 973, 14485, 1527, 15976, 2283, 17274, 3309, 18460, 4619, 19546, 6265, 20609, 8230, 21670,
+		10540, 22779, 13141, 23958, 16014, 25243, 19078, 26648, 22286, 28189, 25545, 29857, 28798, 31660, 31962, 33573,
+		34994, 35589, 37837, 37680, 40472, 39826, 42871, 41997, 45037, 44165, 46970, 46300, 48687, 48374, 50204, 50359,
+		51547, 52235, 52737, 53982, 53800, 55589, 54755, 57047, 55623, 58352, 56419, 59507, 57157, 60517, 57845, 61389,
+		58491, 62135, 59101, 62765, 59677, 63293, 60222, 63731, 60738, 64092, 61223, 64385, 61679, 64623, 62104, 64814,
+		62500, 64967, 62864, 65088, 63199, 65183, 63504, 65258, 63780, 65317, 64028, 65363, 64248, 65399, 64443, 65427,
+		64615, 65448, 64764, 65466, 64893, 65479, 65003, 65489, 65098, 65498, 65177, 65505, 65244, 65510, 65300, 65514,
+		65346, 65518, 65384, 65521, 65415, 65523, 65440, 65525, 65460, 65527, 65477, 65528, 65490, 65530, 65500, 65531,
+		65508, 65531, 65514, 65532, 65519, 65533, 65523, 65533, 65526, 65533, 65528, 65534, 65530, 65534, 65531, 65534,
+		65532, 65534, 65533, 65535, 65533, 65535, 65534, 65535, 65534, 65535, 65534, 65535, 65534, 65535, 65535, 65535,
+		65535, 65535, 65535, 65535, 65535, 65535, 65535, 65535, 65535, 65535, 65535, 65535, 65535, 65535, 65535, 65535,
+		65535, 65535, 65535, 65535, 65535, 65535, 65535, 65535, 65535, 65535, 65535, 65535, 65535, 65535, 65535, 65535,
+		65535, 65535, 65535, 65535, 65535, 65535, 65535, 65535, 65535, 65535, 65535, 65535, 65535, 65535, 65535, 65535,
+		65535, 65535, 65535, 65535, 65535, 65535, 65535, 65535, 0, 0, 0, 3, 0, 7, 0, 21,
+		1, 85, 2, 228, 3, 560, 4, 1061, 9, 1859, 18, 2894, 42, 4230, 84, 5753,
+		167, 7422, 301, 9082, 524, 10666, 857, 12094, 1356, 13369, 2043, 14487, 2979, 15528, 4181, 16505,
+		5697, 17480, 7517, 18475, 9665, 19537, 12096, 20687, 14791, 21964, 17679, 23377, 20714, 24945, 23811, 26663,
+		26914, 28535, 29946, 30541, 32864, 32671, 35615, 34896, 38176, 37196, 40524, 39534, 42658, 41882, 44578, 44203,
+		46300, 46466, 47840, 48641, 49220, 50701, 50461, 52624, 51587, 54397, 52616, 56009, 53568, 57456, 54455, 58738,
+		55291, 59861, 56083, 60833, 56837, 61665, 57557, 62370, 58245, 62962, 58902, 63454, 59527, 63860, 60121, 64192,
+		60681, 64462, 61208, 64679, 61699, 64853, 62154, 64992, 62573, 65103, 62956, 65190, 63303, 65259, 63615, 65314,
+		63894, 65357, 64141, 65391, 64358, 65418, 64548, 65439, 64712, 65456, 64853, 65470, 64973, 65481, 65075, 65490,
+		65160, 65498, 65232, 65504, 65291, 65509, 65340, 65513, 65380, 65517, 65412, 65520, 65438, 65523, 65459, 65525,
+		65476, 65526, 65489, 65528, 65499, 65529, 65508, 65530, 65514, 65531, 65519, 65532, 65523, 65533, 65526, 65533,
+		65528, 65533, 65530, 65534, 65531, 65534, 65532, 65534, 65533, 65534, 65533, 65535, 65534, 65535, 65534, 65535,
+		65534, 65535, 65534, 65535, 65535, 65535, 65535, 65535, 65535, 65535, 65535, 65535, 65535, 65535, 65535, 65535,
+		65535, 65535, 65535, 65535, 65535, 65535, 65535, 65535, 65535, 65535, 65535, 65535, 65535, 65535, 65535, 65535,
+		65535, 65535, 65535, 65535, 65535, 65535, 65535, 65535, 65535, 65535, 65535, 65535, 65535, 65535, 65535, 65535,
+		65535, 65535, 65535, 65535, 65535, 65535, 65535, 65535, 65535, 65535, 65535, 65535, 0, 0, 0, 1,
+		0, 5, 0, 16, 1, 68, 1, 181, 2, 449, 3, 859, 7, 1515, 15, 2370,
+		35, 3472, 71, 4723, 143, 6102, 261, 7475, 458, 8794, 756, 9987, 1202, 11064, 1822, 12021,
+		2670, 12927, 3766, 13792, 5152, 14675, 6828, 15599, 8810, 16606, 11064, 17717, 13574, 18969, 16274, 20375,
+		19122, 21953, 22040, 23699, 24975, 25621, 27855, 27698, 30639, 29920, 33276, 32259, 35744, 34691, 38021, 37177,
+		40106, 39685, 41998, 42174, 43713, 44610, 45264, 46958, 46673, 49189, 47959, 51278, 49145, 53207, 50247, 54965,
+		51283, 56546, 52265, 57950, 53203, 59182, 54105, 60251, 54974, 61168, 55813, 61947, 56623, 62602, 57403, 63149,
+		58150, 63601, 58864, 63971, 59542, 64274, 60181, 64519, 60780, 64717, 61337, 64875, 61852, 65002, 62323, 65104,
+		62751, 65184, 63138, 65249, 63483, 65301, 63790, 65342, 64060, 65375, 64296, 65402, 64501, 65424, 64677, 65442,
+		64827, 65457, 64955, 65469, 65062, 65480, 65151, 65489, 65226, 65496, 65287, 65502, 65337, 65508, 65378, 65512,
+		65411, 65516, 65438, 65519, 65459, 65522, 65476, 65524, 65489, 65526, 65499, 65528, 65507, 65529, 65514, 65530,
+		65519, 65531, 65523, 65532, 65525, 65533, 65528, 65533, 65529, 65533, 65531, 65534, 65532, 65534, 65532, 65534,
+		65533, 65534, 65534, 65535, 65534, 65535, 65534, 65535, 65534, 65535, 65534, 65535, 65535, 65535, 65535, 65535,
+		65535, 65535, 65535, 65535, 65535, 65535, 65535, 65535, 65535, 65535, 65535, 65535, 65535, 65535, 65535, 65535,
+		65535, 65535, 65535, 65535, 65535, 65535, 65535, 65535, 65535, 65535, 65535, 65535, 65535, 65535, 65535, 65535,
+		65535, 65535, 65535, 65535, 65535, 65535, 65535, 65535, 65535, 65535, 65535, 65535, 65535, 65535, 65535, 65535,
+		0, 0, 0, 1, 0, 3, 0, 12, 0, 53, 1, 141, 1, 358, 2, 691,
+		5, 1223, 12, 1916, 29, 2808, 61, 3825, 123, 4951, 226, 6073, 400, 7154, 664, 8139,
+		1062, 9039, 1618, 9845, 2381, 10624, 3373, 11385, 4633, 12180, 6163, 13030, 7978, 13977, 10053, 15042,
+		12369, 16260, 14873, 17646, 17522, 19219, 20247, 20979, 22997, 22933, 25709, 25063, 28339, 27359, 30844, 29791,
+		33201, 32334, 35391, 34947, 37410, 37594, 39260, 40232, 40954, 42823, 42506, 45327, 43934, 47713, 45259, 49953,
+		46500, 52026, 47672, 53919, 48791, 55626, 49869, 57145, 50913, 58480, 51929, 59642, 52919, 60640, 53885, 61491,
+		54825, 62208, 55736, 62808, 56616, 63307, 57461, 63717, 58267, 64053, 59030, 64328, 59748, 64550, 60418, 64730,
+		61038, 64875, 61608, 64992, 62127, 65087, 62596, 65163, 63017, 65225, 63390, 65276, 63720, 65317, 64009, 65351,
+		64259, 65380, 64475, 65403, 64659, 65423, 64816, 65440, 64948, 65455, 65058, 65467, 65150, 65477, 65225, 65486,
+		65287, 65494, 65338, 65501, 65379, 65506, 65412, 65511, 65438, 65515, 65459, 65519, 65476, 65521, 65489, 65524,
+		65499, 65526, 65507, 65528, 65514, 65529, 65519, 65530, 65522, 65531, 65525, 65532, 65527, 65533, 65529, 65533,
+		65530, 65534, 65531, 65534, 65532, 65534, 65533, 65534, 65533, 65534, 65534, 65535, 65534, 65535, 65534, 65535,
+		65534, 65535, 65534, 65535, 65535, 65535, 65535, 65535, 65535, 65535, 65535, 65535, 65535, 65535, 65535, 65535,
+		65535, 65535, 65535, 65535, 65535, 65535, 65535, 65535, 65535, 65535, 65535, 65535, 65535, 65535, 65535, 65535,
+		65535, 65535, 65535, 65535, 65535, 65535, 65535, 65535, 65535, 65535, 65535, 65535, 65535, 65535, 65535, 65535,
+		65535, 65535, 65535, 65535, 0, 0, 0, 0, 0, 3, 0, 9, 0, 41, 1, 108,
+		1, 281, 2, 548, 4, 975, 10, 1530, 25, 2244, 52, 3061, 105, 3970, 195, 4878,
+		348, 5756, 581, 6561, 933, 7305, 1429, 7979, 2112, 8645, 3004, 9310, 4140, 10021, 5527, 10798,
+		7177, 11681, 9070, 12693, 11191, 13869, 13492, 15223, 15934, 16778, 18456, 18534, 21010, 20502, 23538, 22664,
+		26001, 25011, 28358, 27514, 30588, 30144, 32674, 32861, 34613, 35624, 36407, 38389, 38068, 41112, 39609, 43753,
+		41048, 46275, 42403, 48649, 43692, 50852, 44930, 52868, 46130, 54690, 47302, 56315, 48452, 57747, 49584, 58995,
+		50699, 60072, 51796, 60992, 52872, 61770, 53922, 62423, 54942, 62968, 55926, 63419, 56869, 63791, 57765, 64095,
+		58612, 64344, 59404, 64548, 60139, 64714, 60816, 64849, 61434, 64959, 61994, 65050, 62496, 65125, 62944, 65187,
+		63339, 65239, 63686, 65282, 63987, 65319, 64247, 65350, 64469, 65377, 64658, 65400, 64818, 65419, 64951, 65436,
+		65062, 65451, 65154, 65464, 65230, 65475, 65291, 65484, 65341, 65493, 65382, 65500, 65414, 65506, 65440, 65511,
+		65460, 65515, 65476, 65519, 65489, 65522, 65499, 65524, 65507, 65526, 65513, 65528, 65518, 65529, 65522, 65531,
+		65525, 65531, 65527, 65532, 65529, 65533, 65530, 65533, 65531, 65534, 65532, 65534, 65532, 65534, 65533, 65534,
+		65533, 65535, 65534, 65535, 65534, 65535, 65534, 65535, 65534, 65535, 65534, 65535, 65535, 65535, 65535, 65535,
+		65535, 65535, 65535, 65535, 65535, 65535, 65535, 65535, 65535, 65535, 65535, 65535, 65535, 65535, 65535, 65535,
+		65535, 65535, 65535, 65535, 65535, 65535, 65535, 65535, 65535, 65535, 65535, 65535, 65535, 65535, 65535, 65535,
+		65535, 65535, 65535, 65535, 65535, 65535, 65535, 65535, 0, 0, 0, 0, 0, 2, 0, 6,
+		0, 32, 0, 84, 0, 220, 1, 429, 3, 766, 8, 1208, 20, 1774, 44, 2423,
+		89, 3144, 168, 3868, 300, 4575, 505, 5228, 815, 5836, 1255, 6394, 1861, 6958, 2658, 7534,
+		3675, 8167, 4922, 8872, 6410, 9691, 8123, 10646, 10048, 11773, 12144, 13087, 14374, 14613, 16686, 16354,
+		19034, 18320, 21370, 20498, 23653, 22877, 25850, 25429, 27941, 28125, 29911, 30922, 31757, 33778, 33483, 36646,
+		35099, 39480, 36618, 42235, 38058, 44875, 39435, 47365, 40766, 49682, 42063, 51807, 43339, 53732, 44601, 55453,
+		45856, 56975, 47103, 58304, 48344, 59454, 49574, 60440, 50789, 61277, 51983, 61983, 53148, 62574, 54278, 63066,
+		55364, 63475, 56402, 63812, 57383, 64090, 58305, 64319, 59163, 64508, 59954, 64665, 60679, 64794, 61336, 64902,
+		61927, 64993, 62454, 65069, 62920, 65134, 63329, 65189, 63685, 65237, 63993, 65278, 64257, 65313, 64481, 65344,
+		64671, 65371, 64830, 65395, 64963, 65415, 65073, 65433, 65163, 65449, 65237, 65462, 65297, 65474, 65346, 65484,
+		65385, 65492, 65416, 65500, 65441, 65506, 65461, 65511, 65476, 65516, 65489, 65519, 65499, 65522, 65506, 65525,
+		65512, 65527, 65517, 65528, 65521, 65530, 65524, 65531, 65526, 65532, 65528, 65532, 65529, 65533, 65530, 65533,
+		65531, 65534, 65532, 65534, 65533, 65534, 65533, 65534, 65533, 65535, 65534, 65535, 65534, 65535, 65534, 65535,
+		65534, 65535, 65534, 65535, 65535, 65535, 65535, 65535, 65535, 65535, 65535, 65535, 65535, 65535, 65535, 65535,
+		65535, 65535, 65535, 65535, 65535, 65535, 65535, 65535, 65535, 65535, 65535, 65535, 65535, 65535, 65535, 65535,
+		65535, 65535, 65535, 65535, 65535, 65535, 65535, 65535, 65535, 65535, 65535, 65535, 0, 0, 0, 0,
+		0, 2, 0, 5, 0, 24, 0, 64, 0, 169, 1, 331, 3, 595, 7, 941,
+		17, 1385, 37, 1895, 76, 2465, 144, 3038, 259, 3601, 437, 4124, 708, 4618, 1095, 5078,
+		1630, 5553, 2336, 6049, 3240, 6608, 4352, 7246, 5682, 8002, 7220, 8899, 8951, 9973, 10843, 11240,
+		12861, 12727, 14961, 14440, 17100, 16390, 19235, 18565, 21332, 20957, 23361, 23537, 25303, 26275, 27146, 29128,
+		28889, 32052, 30537, 34998, 32098, 37919, 33586, 40767, 35017, 43503, 36407, 46091, 37770, 48505, 39120, 50725,
+		40465, 52740, 41814, 54548, 43168, 56150, 44529, 57554, 45893, 58773, 47256, 59821, 48611, 60716, 49949, 61473,
+		51262, 62111, 52540, 62646, 53775, 63093, 54957, 63465, 56079, 63775, 57136, 64033, 58121, 64249, 59033, 64430,
+		59868, 64582, 60628, 64711, 61312, 64821, 61923, 64915, 62465, 64996, 62940, 65066, 63355, 65128, 63714, 65182,
+		64022, 65229, 64285, 65270, 64507, 65307, 64694, 65339, 64850, 65367, 64979, 65392, 65086, 65413, 65174, 65432,
+		65245, 65449, 65303, 65463, 65350, 65475, 65387, 65485, 65417, 65494, 65441, 65501, 65460, 65507, 65476, 65512,
+		65488, 65517, 65497, 65520, 65505, 65523, 65511, 65526, 65515, 65528, 65519, 65529, 65522, 65530, 65525, 65531,
+		65527, 65532, 65528, 65533, 65529, 65533, 65530, 65534, 65531, 65534, 65532, 65534, 65533, 65534, 65533, 65535,
+		65533, 65535, 65534, 65535, 65534, 65535, 65534, 65535, 65534, 65535, 65534, 65535, 65535, 65535, 65535, 65535,
+		65535, 65535, 65535, 65535, 65535, 65535, 65535, 65535, 65535, 65535, 65535, 65535, 65535, 65535, 65535, 65535,
+		65535, 65535, 65535, 65535, 65535, 65535, 65535, 65535, 65535, 65535, 65535, 65535, 65535, 65535, 65535, 65535,
+		0, 0, 0, 0, 0, 1, 0, 4, 0, 19, 0, 49, 0, 130, 1, 255,
+		2, 460, 6, 726, 14, 1069, 31, 1466, 64, 1911, 122, 2360, 221, 2804, 376, 3218,
+		612, 3615, 950, 3993, 1418, 4390, 2039, 4816, 2836, 5308, 3820, 5882, 4999, 6578, 6367, 7417,
+		7910, 8435, 9601, 9651, 11410, 11093, 13298, 12768, 15227, 14689, 17161, 16848, 19069, 19235, 20925, 21822,
+		22712, 24581, 24423, 27467, 26055, 30436, 27616, 33437, 29113, 36422, 30562, 39340, 31975, 42151, 33369, 44817,
+		34757, 47311, 36150, 49610, 37557, 51703, 38984, 53586, 40432, 55260, 41900, 56732, 43384, 58014, 44877, 59122,
+		46369, 60071, 47851, 60880, 49311, 61565, 50738, 62144, 52122, 62631, 53451, 63041, 54716, 63386, 55910, 63677,
+		57026, 63923, 58061, 64133, 59011, 64312, 59876, 64466, 60657, 64599, 61356, 64716, 61976, 64817, 62522, 64907,
+		62998, 64986, 63411, 65056, 63766, 65118, 64069, 65173, 64326, 65222, 64542, 65265, 64723, 65304, 64873, 65337,
+		64998, 65367, 65100, 65392, 65184, 65415, 65252, 65434, 65307, 65451, 65352, 65465, 65388, 65477, 65417, 65487,
+		65440, 65496, 65458, 65503, 65473, 65509, 65485, 65514, 65494, 65518, 65502, 65522, 65508, 65524, 65513, 65527,
+		65517, 65528, 65520, 65530, 65523, 65531, 65525, 65532, 65527, 65533, 65528, 65533, 65529, 65534, 65530, 65534,
+		65531, 65534, 65532, 65534, 65532, 65535, 65533, 65535, 65533, 65535, 65534, 65535, 65534, 65535, 65534, 65535,
+		65534, 65535, 65534, 65535, 65535, 65535, 65535, 65535, 65535, 65535, 65535, 65535, 65535, 65535, 65535, 65535,
+		65535, 65535, 65535, 65535, 65535, 65535, 65535, 65535, 65535, 65535, 65535, 65535, 65535, 65535, 65535, 65535,
+		65535, 65535, 65535, 65535, 0, 0, 0, 0, 0, 1, 0, 3, 0, 14, 0, 37,
+		0, 99, 1, 195, 2, 352, 5, 555, 12, 819, 26, 1125, 54, 1468, 104, 1817,
+		188, 2164, 321, 2490, 525, 2808, 818, 3115, 1225, 3447, 1767, 3811, 2463, 4244, 3327, 4761,
+		4363, 5399, 5569, 6181, 6931, 7143, 8430, 8304, 10035, 9694, 11717, 11323, 13441, 13204, 15176, 15331,
+		16894, 17695, 18577, 20271, 20208, 23029, 21782, 25926, 23300, 28917, 24768, 31949, 26195, 34973, 27595, 37940,
+		28983, 40805, 30372, 43529, 31775, 46085, 33203, 48448, 34663, 50606, 36161, 52552, 37694, 54289, 39263, 55821,
+		40859, 57163, 42475, 58326, 44100, 59329, 45721, 60188, 47325, 60921, 48899, 61545, 50429, 62075, 51904, 62526,
+		53312, 62910, 54643, 63238, 55891, 63519, 57050, 63762, 58116, 63973, 59089, 64157, 59968, 64319, 60756, 64463,
+		61457, 64590, 62074, 64703, 62614, 64804, 63083, 64895, 63486, 64976, 63831, 65048, 64125, 65113, 64372, 65170,
+		64580, 65221, 64753, 65266, 64896, 65306, 65014, 65341, 65112, 65371, 65191, 65397, 65256, 65420, 65308, 65439,
+		65351, 65456, 65385, 65470, 65413, 65482, 65436, 65491, 65454, 65500, 65469, 65506, 65481, 65512, 65490, 65517,
+		65498, 65520, 65505, 65523, 65510, 65526, 65514, 65528, 65518, 65529, 65521, 65531, 65523, 65532, 65525, 65532,
+		65527, 65533, 65528, 65533, 65529, 65534, 65530, 65534, 65531, 65534, 65532, 65534, 65532, 65535, 65533, 65535,
+		65533, 65535, 65534, 65535, 65534, 65535, 65534, 65535, 65534, 65535, 65534, 65535, 65535, 65535, 65535, 65535,
+		65535, 65535, 65535, 65535, 65535, 65535, 65535, 65535, 65535, 65535, 65535, 65535, 65535, 65535, 65535, 65535,
+		65535, 65535, 65535, 65535, 65535, 65535, 65535, 65535, 0, 0, 0, 0, 0, 1, 0, 2,
+		0, 11, 0, 28, 0, 74, 0, 146, 1, 266, 4, 420, 10, 621, 22, 855,
+		45, 1118, 87, 1387, 159, 1655, 273, 1910, 447, 2162, 699, 2410, 1050, 2687, 1520, 2998,
+		2124, 3378, 2875, 3843, 3777, 4427, 4830, 5154, 6022, 6058, 7337, 7164, 8749, 8498, 10233, 10074,
+		11758, 11905, 13300, 13988, 14834, 16315, 16346, 18862, 17822, 21601, 19260, 24487, 20660, 27477, 22032, 30518,
+		23384, 33560, 24730, 36552, 26085, 39450, 27461, 42215, 28871, 44814, 30324, 47225, 31828, 49434, 33386, 51433,
+		34996, 53223, 36655, 54809, 38355, 56203, 40087, 57419, 41836, 58472, 43590, 59381, 45332, 60163, 47047, 60834,
+		48720, 61410, 50336, 61905, 51883, 62332, 53350, 62702, 54728, 63024, 56010, 63306, 57191, 63554, 58271, 63774,
+		59249, 63971, 60127, 64146, 60908, 64304, 61598, 64447, 62203, 64575, 62728, 64691, 63182, 64795, 63571, 64889,
+		63902, 64974, 64182, 65049, 64417, 65116, 64614, 65176, 64778, 65229, 64913, 65275, 65025, 65315, 65117, 65350,
+		65193, 65380, 65254, 65406, 65305, 65428, 65346, 65447, 65379, 65463, 65406, 65476, 65429, 65487, 65447, 65496,
+		65462, 65504, 65474, 65510, 65484, 65515, 65493, 65519, 65500, 65523, 65505, 65525, 65510, 65527, 65514, 65529,
+		65518, 65530, 65521, 65531, 65523, 65532, 65525, 65533, 65527, 65533, 65528, 65534, 65529, 65534, 65530, 65534,
+		65531, 65534, 65532, 65535, 65533, 65535, 65533, 65535, 65533, 65535, 65534, 65535, 65534, 65535, 65534, 65535,
+		65534, 65535, 65534, 65535, 65535, 65535, 65535, 65535, 65535, 65535, 65535, 65535, 65535, 65535, 65535, 65535,
+		65535, 65535, 65535, 65535, 65535, 65535, 65535, 65535, 65535, 65535, 65535, 65535, 0, 0, 0, 0,
+		0, 1, 0, 2, 0, 8, 0, 20, 0, 55, 0, 110, 1, 200, 3, 315,
+		8, 467, 18, 644, 38, 845, 73, 1050, 133, 1257, 230, 1454, 378, 1653, 594, 1854,
+		894, 2083, 1297, 2350, 1816, 2683, 2464, 3101, 3243, 3634, 4155, 4309, 5188, 5158, 6331, 6206,
+		7561, 7480, 8857, 8997, 10195, 10770, 11553, 12798, 12911, 15074, 14257, 17576, 15582, 20276, 16886, 23132,
+		18170, 26100, 19444, 29128, 20718, 32166, 22006, 35163, 23320, 38074, 24676, 40859, 26084, 43485, 27554, 45929,
+		29091, 48175, 30699, 50214, 32375, 52048, 34114, 53680, 35908, 55121, 37744, 56385, 39609, 57488, 41485, 58446,
+		43357, 59277, 45205, 59996, 47013, 60621, 48765, 61165, 50446, 61639, 52043, 62056, 53547, 62424, 54948, 62751,
+		56242, 63043, 57427, 63306, 58502, 63543, 59469, 63757, 60331, 63953, 61094, 64130, 61763, 64292, 62346, 64439,
+		62850, 64572, 63284, 64692, 63654, 64801, 63967, 64899, 64232, 64986, 64454, 65063, 64640, 65132, 64794, 65192,
+		64922, 65245, 65028, 65291, 65115, 65330, 65187, 65365, 65246, 65394, 65295, 65418, 65335, 65439, 65368, 65457,
+		65395, 65471, 65418, 65484, 65437, 65494, 65453, 65502, 65466, 65509, 65477, 65514, 65486, 65518, 65494, 65522,
+		65500, 65525, 65506, 65527, 65510, 65529, 65514, 65530, 65518, 65531, 65521, 65532, 65523, 65533, 65525, 65533,
+		65527, 65534, 65528, 65534, 65529, 65534, 65530, 65534, 65531, 65535, 65532, 65535, 65533, 65535, 65533, 65535,
+		65533, 65535, 65534, 65535, 65534, 65535, 65534, 65535, 65534, 65535, 65535, 65535, 65535, 65535, 65535, 65535,
+		65535, 65535, 65535, 65535, 65535, 65535, 65535, 65535, 65535, 65535, 65535, 65535, 65535, 65535, 65535, 65535,
+		0, 0, 0, 0, 0, 1, 0, 1, 0, 6, 0, 15, 0, 41, 0, 81,
+		1, 148, 3, 234, 6, 348, 15, 481, 31, 633, 61, 787, 111, 945, 193, 1097,
+		318, 1254, 500, 1415, 755, 1606, 1098, 1834, 1541, 2127, 2094, 2502, 2761, 2989, 3543, 3614,
+		4431, 4409, 5415, 5398, 6476, 6611, 7599, 8064, 8760, 9772, 9946, 11735, 11138, 13948, 12328, 16390,
+		13509, 19035, 14683, 21843, 15853, 24769, 17030, 27765, 18224, 30778, 19450, 33760, 20721, 36664, 22050, 39451,
+		23448, 42087, 24925, 44548, 26486, 46817, 28133, 48886, 29863, 50753, 31671, 52423, 33546, 53905, 35475, 55213,
+		37443, 56362, 39431, 57369, 41421, 58249, 43393, 59020, 45327, 59696, 47205, 60292, 49012, 60819, 50733, 61288,
+		52356, 61708, 53871, 62087, 55274, 62429, 56560, 62741, 57729, 63026, 58783, 63287, 59724, 63526, 60558, 63746,
+		61292, 63947, 61932, 64131, 62488, 64299, 62966, 64451, 63376, 64590, 63724, 64714, 64019, 64825, 64268, 64925,
+		64477, 65013, 64651, 65090, 64797, 65158, 64918, 65218, 65019, 65269, 65103, 65313, 65172, 65351, 65230, 65383,
+		65278, 65410, 65318, 65433, 65352, 65452, 65380, 65468, 65404, 65481, 65424, 65491, 65441, 65500, 65455, 65507,
+		65467, 65513, 65478, 65518, 65486, 65521, 65494, 65524, 65500, 65527, 65506, 65528, 65511, 65530, 65515, 65531,
+		65518, 65532, 65521, 65533, 65523, 65533, 65525, 65534, 65527, 65534, 65529, 65534, 65530, 65534, 65531, 65535,
+		65532, 65535, 65532, 65535, 65533, 65535, 65533, 65535, 65534, 65535, 65534, 65535, 65534, 65535, 65534, 65535,
+		65534, 65535, 65535, 65535, 65535, 65535, 65535, 65535, 65535, 65535, 65535, 65535, 65535, 65535, 65535, 65535,
+		65535, 65535, 65535, 65535, 0, 0, 0, 0, 0, 0, 0, 1, 0, 4, 0, 11,
+		0, 30, 0, 59, 1, 109, 2, 172, 5, 257, 12, 357, 25, 470, 50, 586,
+		92, 706, 160, 822, 265, 945, 418, 1076, 633, 1235, 923, 1431, 1297, 1688, 1765, 2025,
+		2331, 2470, 2995, 3047, 3751, 3789, 4591, 4720, 5499, 5870, 6462, 7254, 7462, 8891, 8488, 10780,
+		9526, 12919, 10570, 15288, 11616, 17863, 12667, 20605, 13728, 23472, 14810, 26415, 15925, 29385, 17087, 32332,
+		18310, 35211, 19606, 37981, 20987, 40610, 22463, 43072, 24037, 45351, 25712, 47437, 27485, 49328, 29349, 51027,
+		31292, 52545, 33302, 53893, 35360, 55086, 37447, 56139, 39542, 57070, 41625, 57893, 43673, 58624, 45668, 59276,
+		47591, 59861, 49426, 60388, 51160, 60866, 52783, 61303, 54288, 61703, 55670, 62072, 56929, 62412, 58066, 62727,
+		59084, 63018, 59988, 63287, 60785, 63534, 61482, 63762, 62088, 63971, 62612, 64162, 63062, 64335, 63447, 64491,
+		63774, 64632, 64050, 64757, 64284, 64869, 64480, 64967, 64646, 65053, 64784, 65129, 64900, 65194, 64997, 65250,
+		65079, 65298, 65147, 65339, 65205, 65374, 65254, 65403, 65295, 65428, 65330, 65448, 65360, 65465, 65386, 65478,
+		65407, 65490, 65426, 65499, 65442, 65506, 65456, 65512, 65468, 65517, 65478, 65521, 65487, 65524, 65494, 65526,
+		65501, 65528, 65506, 65530, 65511, 65531, 65515, 65532, 65518, 65533, 65521, 65533, 65524, 65534, 65526, 65534,
+		65527, 65534, 65529, 65534, 65530, 65534, 65531, 65535, 65532, 65535, 65532, 65535, 65533, 65535, 65533, 65535,
+		65534, 65535, 65534, 65535, 65534, 65535, 65534, 65535, 65535, 65535, 65535, 65535, 65535, 65535, 65535, 65535,
+		65535, 65535, 65535, 65535, 65535, 65535, 65535, 65535, 0, 0, 0, 0, 0, 0, 0, 1,
+		0, 3, 0, 8, 0, 22, 0, 43, 1, 79, 2, 125, 4, 189, 10, 262,
+		21, 346, 41, 432, 76, 522, 132, 611, 219, 707, 347, 813, 526, 945, 769, 1114,
+		1082, 1341, 1476, 1644, 1951, 2049, 2511, 2582, 3148, 3272, 3858, 4145, 4628, 5230, 5447, 6543,
+		6302, 8103, 7183, 9912, 8079, 11967, 8989, 14252, 9909, 16744, 10845, 19405, 11803, 22196, 12795, 25070,
+		13832, 27978, 14929, 30872, 16101, 33707, 17360, 36444, 18718, 39049, 20184, 41498, 21762, 43773, 23455, 45864,
+		25258, 47768, 27165, 49489, 29163, 51035, 31239, 52418, 33373, 53651, 35544, 54751, 37731, 55731, 39911, 56609,
+		42061, 57397, 44160, 58109, 46187, 58756, 48126, 59347, 49962, 59890, 51684, 60392, 53283, 60858, 54756, 61291,
+		56100, 61694, 57315, 62070, 58406, 62421, 59377, 62746, 60236, 63048, 60989, 63327, 61646, 63584, 62215, 63819,
+		62706, 64033, 63128, 64227, 63488, 64402, 63794, 64558, 64054, 64697, 64275, 64820, 64461, 64928, 64619, 65022,
+		64753, 65104, 64865, 65175, 64961, 65235, 65043, 65287, 65112, 65331, 65171, 65367, 65222, 65398, 65266, 65424,
+		65303, 65445, 65336, 65462, 65364, 65477, 65388, 65488, 65409, 65498, 65427, 65506, 65443, 65512, 65457, 65517,
+		65469, 65520, 65479, 65524, 65488, 65526, 65495, 65528, 65502, 65530, 65507, 65531, 65512, 65532, 65516, 65532,
+		65519, 65533, 65522, 65533, 65524, 65534, 65526, 65534, 65528, 65534, 65529, 65534, 65530, 65535, 65531, 65535,
+		65532, 65535, 65533, 65535, 65533, 65535, 65534, 65535, 65534, 65535, 65534, 65535, 65534, 65535, 65534, 65535,
+		65535, 65535, 65535, 65535, 65535, 65535, 65535, 65535, 65535, 65535, 65535, 65535, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 2, 0, 5, 0, 16, 0, 31, 0, 57, 1, 90,
+		3, 137, 8, 190, 17, 252, 33, 316, 62, 383, 108, 451, 180, 526, 286, 611,
+		434, 723, 636, 868, 896, 1069, 1223, 1341, 1620, 1710, 2087, 2200, 2620, 2841, 3215, 3656,
+		3862, 4675, 4553, 5915, 5277, 7394, 6028, 9116, 6797, 11080, 7585, 13270, 8390, 15666, 9220, 18233,
+		10082, 20934, 10987, 23721, 11949, 26551, 12983, 29374, 14102, 32149, 15321, 34836, 16649, 37402, 18098, 39823,
+		19671, 42080, 21371, 44164, 23193, 46072, 25130, 47806, 27169, 49374, 29296, 50786, 31491, 52056, 33732, 53200,
+		35996, 54230, 38258, 55163, 40495, 56011, 42683, 56787, 44802, 57500, 46833, 58161, 48761, 58775, 50572, 59349,
+		52257, 59887, 53813, 60392, 55235, 60866, 56524, 61312, 57684, 61730, 58719, 62120, 59637, 62484, 60445, 62822,
+		61152, 63133, 61767, 63420, 62300, 63681, 62759, 63918, 63154, 64132, 63492, 64324, 63780, 64495, 64027, 64647,
+		64237, 64780, 64417, 64897, 64570, 64998, 64702, 65086, 64814, 65161, 64911, 65225, 64994, 65279, 65066, 65325,
+		65129, 65363, 65183, 65395, 65230, 65421, 65272, 65443, 65308, 65461, 65339, 65476, 65366, 65488, 65390, 65497,
+		65411, 65505, 65429, 65511, 65445, 65516, 65459, 65520, 65471, 65523, 65481, 65526, 65490, 65528, 65497, 65529,
+		65504, 65530, 65509, 65531, 65513, 65532, 65517, 65533, 65520, 65533, 65523, 65534, 65525, 65534, 65527, 65534,
+		65529, 65534, 65530, 65534, 65531, 65535, 65532, 65535, 65532, 65535, 65533, 65535, 65533, 65535, 65534, 65535,
+		65534, 65535, 65534, 65535, 65534, 65535, 65535, 65535, 65535, 65535, 65535, 65535, 65535, 65535, 65535, 65535,
+		0, 0, 0, 0, 0, 0, 0, 0, 0, 2, 0, 4, 0, 11, 0, 22,
+		0, 41, 1, 64, 3, 98, 6, 137, 13, 182, 27, 229, 50, 279, 88, 330,
+		147, 389, 234, 458, 356, 551, 521, 678, 736, 855, 1006, 1099, 1333, 1435, 1720, 1885,
+		2162, 2477, 2656, 3235, 3196, 4188, 3774, 5353, 4383, 6748, 5018, 8378, 5674, 10244, 6353, 12332,
+		7055, 14622, 7788, 17083, 8560, 19679, 9385, 22367, 10275, 25103, 11246, 27841, 12312, 30539, 13487, 33160,
+		14782, 35672, 16207, 38050, 17767, 40277, 19463, 42342, 21292, 44243, 23246, 45981, 25313, 47563, 27477, 49000,
+		29718, 50303, 32013, 51488, 34337, 52568, 36667, 53556, 38975, 54466, 41239, 55308, 43436, 56093, 45546, 56828,
+		47553, 57519, 49442, 58171, 51205, 58788, 52835, 59373, 54328, 59926, 55686, 60449, 56910, 60943, 58006, 61406,
+		58980, 61839, 59841, 62243, 60597, 62617, 61258, 62961, 61833, 63276, 62332, 63563, 62762, 63822, 63134, 64055,
+		63454, 64262, 63729, 64447, 63966, 64609, 64171, 64751, 64347, 64875, 64500, 64981, 64632, 65073, 64747, 65151,
+		64847, 65218, 64935, 65274, 65011, 65321, 65079, 65361, 65138, 65393, 65190, 65420, 65236, 65442, 65276, 65460,
+		65312, 65475, 65343, 65487, 65370, 65497, 65394, 65504, 65415, 65511, 65433, 65516, 65449, 65520, 65462, 65523,
+		65474, 65525, 65484, 65527, 65493, 65529, 65500, 65530, 65506, 65531, 65511, 65532, 65515, 65533, 65519, 65533,
+		65522, 65533, 65524, 65534, 65526, 65534, 65528, 65534, 65529, 65534, 65531, 65534, 65531, 65535, 65532, 65535,
+		65533, 65535, 65533, 65535, 65534, 65535, 65534, 65535, 65534, 65535, 65534, 65535, 65534, 65535, 65535, 65535,
+		65535, 65535, 65535, 65535, 0, 0, 0, 0, 0, 0, 0, 0, 0, 1, 0, 3,
+		0, 8, 0, 15, 0, 29, 1, 46, 2, 70, 5, 98, 11, 131, 22, 165,
+		40, 201, 71, 240, 119, 286, 189, 343, 289, 422, 424, 531, 599, 688, 820, 908,
+		1088, 1213, 1405, 1624, 1769, 2169, 2177, 2872, 2623, 3758, 3103, 4847, 3611, 6156, 4146, 7690,
+		4702, 9452, 5284, 11430, 5893, 13606, 6540, 15951, 7231, 18432, 7981, 21006, 8803, 23635, 9714, 26272,
+		10726, 28880, 11855, 31421, 13112, 33864, 14508, 36186, 16046, 38370, 17729, 40405, 19554, 42289, 21513, 44022,
+		23594, 45611, 25780, 47066, 28051, 48398, 30384, 49622, 32754, 50749, 35134, 51793, 37499, 52766, 39823, 53677,
+		42083, 54536, 44259, 55350, 46333, 56123, 48289, 56860, 50119, 57563, 51814, 58234, 53372, 58874, 54791, 59482,
+		56075, 60058, 57228, 60602, 58256, 61113, 59168, 61590, 59973, 62033, 60679, 62441, 61297, 62817, 61836, 63159,
+		62305, 63468, 62712, 63747, 63066, 63996, 63373, 64217, 63640, 64412, 63872, 64583, 64075, 64732, 64252, 64861,
+		64408, 64972, 64544, 65067, 64665, 65147, 64771, 65215, 64865, 65272, 64948, 65320, 65022, 65360, 65088, 65393,
+		65146, 65420, 65197, 65442, 65243, 65460, 65283, 65475, 65318, 65486, 65349, 65496, 65377, 65504, 65400, 65510,
+		65421, 65515, 65439, 65519, 65454, 65522, 65467, 65525, 65478, 65527, 65488, 65528, 65496, 65530, 65503, 65531,
+		65509, 65532, 65513, 65532, 65517, 65533, 65521, 65533, 65524, 65533, 65526, 65534, 65528, 65534, 65529, 65534,
+		65530, 65534, 65531, 65534, 65532, 65535, 65533, 65535, 65533, 65535, 65534, 65535, 65534, 65535, 65534, 65535,
+		65534, 65535, 65534, 65535, 65535, 65535, 65535, 65535, 0, 0, 0, 0, 0, 0, 0, 0,
+		0, 1, 0, 2, 0, 5, 0, 11, 0, 20, 1, 32, 2, 49, 4, 69,
+		8, 93, 17, 117, 32, 144, 57, 173, 95, 210, 152, 256, 233, 323, 342, 419,
+		484, 557, 663, 754, 881, 1030, 1139, 1405, 1436, 1905, 1769, 2553, 2136, 3374, 2532, 4386,
+		2953, 5607, 3400, 7044, 3870, 8698, 4367, 10561, 4895, 12616, 5463, 14836, 6081, 17191, 6762, 19642,
+		7521, 22151, 8372, 24676, 9332, 27180, 10414, 29627, 11630, 31989, 12990, 34243, 14500, 36372, 16161, 38366,
+		17972, 40222, 19924, 41941, 22005, 43530, 24199, 44997, 26486, 46353, 28841, 47612, 31239, 48785, 33655, 49884,
+		36060, 50920, 38429, 51902, 40738, 52838, 42966, 53734, 45093, 54594, 47105, 55420, 48991, 56216, 50743, 56980,
+		52356, 57712, 53831, 58412, 55169, 59079, 56374, 59710, 57453, 60305, 58415, 60862, 59267, 61381, 60019, 61861,
+		60681, 62303, 61262, 62706, 61771, 63072, 62217, 63401, 62607, 63696, 62949, 63958, 63250, 64190, 63514, 64393,
+		63747, 64570, 63953, 64723, 64135, 64856, 64298, 64969, 64442, 65065, 64570, 65146, 64685, 65215, 64788, 65272,
+		64880, 65320, 64961, 65360, 65034, 65392, 65099, 65419, 65157, 65441, 65209, 65459, 65254, 65474, 65294, 65486,
+		65329, 65495, 65359, 65503, 65386, 65509, 65409, 65514, 65429, 65518, 65446, 65521, 65461, 65524, 65473, 65526,
+		65484, 65528, 65493, 65529, 65500, 65530, 65507, 65531, 65512, 65532, 65516, 65532, 65520, 65533, 65523, 65533,
+		65525, 65534, 65527, 65534, 65529, 65534, 65530, 65534, 65531, 65534, 65532, 65534, 65533, 65535, 65533, 65535,
+		65533, 65535, 65534, 65535, 65534, 65535, 65534, 65535, 65534, 65535, 65535, 65535, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 1, 0, 4, 0, 7, 0, 14, 0, 22,
+		1, 34, 3, 48, 7, 65, 14, 83, 25, 102, 45, 124, 76, 153, 122, 192,
+		186, 249, 274, 332, 388, 455, 532, 631, 707, 880, 916, 1221, 1156, 1677, 1427, 2272,
+		1726, 3028, 2050, 3964, 2398, 5097, 2770, 6434, 3165, 7978, 3588, 9722, 4044, 11650, 4543, 13739,
+		5095, 15961, 5714, 18279, 6413, 20658, 7209, 23060, 8116, 25448, 9150, 27791, 10322, 30060, 11642, 32233,
+		13117, 34296, 14749, 36239, 16535, 38058, 18469, 39755, 20538, 41336, 22727, 42808, 25013, 44184, 27376, 45474,
+		29787, 46689, 32222, 47842, 34651, 48941, 37050, 49994, 39393, 51009, 41658, 51990, 43826, 52940, 45882, 53861,
+		47813, 54753, 49611, 55615, 51273, 56447, 52795, 57245, 54182, 58008, 55436, 58733, 56563, 59419, 57572, 60063,
+		58470, 60664, 59268, 61221, 59975, 61735, 60599, 62204, 61151, 62631, 61638, 63016, 62069, 63361, 62450, 63668,
+		62788, 63940, 63088, 64179, 63355, 64387, 63594, 64568, 63808, 64724, 64000, 64857, 64172, 64971, 64327, 65067,
+		64467, 65149, 64593, 65217, 64706, 65274, 64807, 65321, 64898, 65360, 64980, 65392, 65052, 65419, 65117, 65441,
+		65174, 65458, 65225, 65473, 65270, 65484, 65309, 65494, 65343, 65501, 65372, 65508, 65398, 65513, 65420, 65517,
+		65439, 65520, 65455, 65523, 65469, 65525, 65480, 65527, 65490, 65528, 65498, 65529, 65505, 65530, 65510, 65531,
+		65515, 65532, 65519, 65532, 65522, 65533, 65525, 65533, 65527, 65534, 65528, 65534, 65530, 65534, 65531, 65534,
+		65532, 65534, 65532, 65534, 65533, 65535, 65533, 65535, 65534, 65535, 65534, 65535, 65534, 65535, 65534, 65535,
+		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 1, 0, 2, 0, 5,
+		0, 9, 0, 15, 1, 24, 2, 34, 5, 45, 11, 58, 20, 72, 36, 89,
+		60, 112, 96, 144, 147, 193, 217, 265, 308, 374, 423, 531, 564, 755, 731, 1063,
+		924, 1479, 1142, 2022, 1384, 2715, 1648, 3576, 1934, 4621, 2242, 5858, 2573, 7290, 2933, 8912,
+		3327, 10710, 3765, 12663, 4258, 14746, 4819, 16924, 5464, 19166, 6206, 21435, 7063, 23699, 8047, 25926,
+		9173, 28092, 10449, 30175, 11884, 32162, 13479, 34044, 15232, 35817, 17137, 37484, 19182, 39049, 21351, 40522,
+		23624, 41911, 25979, 43227, 28388, 44482, 30826, 45686, 33264, 46846, 35677, 47971, 38039, 49065, 40328, 50132,
+		42523, 51175, 44610, 52193, 46576, 53185, 48411, 54150, 50112, 55085, 51676, 55987, 53105, 56851, 54403, 57676,
+		55575, 58458, 56629, 59195, 57573, 59883, 58417, 60523, 59169, 61114, 59839, 61655, 60435, 62148, 60967, 62592,
+		61441, 62992, 61865, 63348, 62245, 63663, 62586, 63940, 62892, 64182, 63169, 64393, 63419, 64575, 63646, 64731,
+		63851, 64864, 64037, 64977, 64206, 65072, 64358, 65152, 64497, 65219, 64622, 65275, 64734, 65322, 64835, 65360,
+		64926, 65392, 65006, 65418, 65078, 65439, 65142, 65456, 65198, 65471, 65247, 65482, 65290, 65492, 65328, 65499,
+		65360, 65506, 65388, 65511, 65412, 65515, 65432, 65519, 65450, 65521, 65465, 65524, 65477, 65526, 65487, 65527,
+		65496, 65529, 65503, 65530, 65509, 65531, 65514, 65531, 65518, 65532, 65521, 65533, 65524, 65533, 65526, 65533,
+		65528, 65534, 65529, 65534, 65531, 65534, 65532, 65534, 65532, 65534, 65533, 65535, 65533, 65535, 65534, 65535,
+		65534, 65535, 65534, 65535, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 1,
+		0, 2, 0, 3, 0, 6, 0, 10, 1, 16, 2, 23, 4, 31, 8, 40,
+		16, 51, 28, 64, 47, 82, 76, 109, 116, 151, 171, 214, 243, 310, 334, 450,
+		446, 650, 579, 928, 733, 1304, 908, 1797, 1102, 2429, 1316, 3216, 1549, 4175, 1804, 5313,
+		2081, 6633, 2386, 8132, 2727, 9799, 3112, 11613, 3552, 13552, 4062, 15586, 4655, 17684, 5347, 19814,
+		6154, 21945, 7089, 24050, 8166, 26104, 9396, 28088, 10784, 29991, 12335, 31803, 14047, 33522, 15913, 35151,
+		17923, 36694, 20061, 38159, 22308, 39556, 24640, 40895, 27032, 42186, 29458, 43437, 31890, 44657, 34302, 45851,
+		36669, 47024, 38967, 48178, 41177, 49314, 43283, 50431, 45272, 51527, 47135, 52597, 48867, 53639, 50465, 54647,
+		51931, 55618, 53268, 56547, 54481, 57430, 55578, 58263, 56567, 59044, 57456, 59771, 58255, 60443, 58972, 61060,
+		59616, 61622, 60196, 62131, 60718, 62588, 61188, 62996, 61614, 63357, 62001, 63676, 62352, 63955, 62671, 64197,
+		62963, 64407, 63229, 64587, 63472, 64742, 63694, 64873, 63897, 64984, 64081, 65077, 64249, 65156, 64401, 65221,
+		64539, 65276, 64663, 65321, 64775, 65359, 64874, 65390, 64963, 65415, 65042, 65436, 65112, 65453, 65173, 65467,
+		65227, 65479, 65274, 65489, 65314, 65497, 65349, 65503, 65379, 65508, 65405, 65513, 65427, 65517, 65446, 65520,
+		65461, 65522, 65474, 65524, 65485, 65526, 65494, 65528, 65502, 65529, 65508, 65530, 65513, 65531, 65517, 65531,
+		65521, 65532, 65524, 65533, 65526, 65533, 65528, 65533, 65529, 65534, 65530, 65534, 65531, 65534, 65532, 65534,
+		65533, 65534, 65533, 65535, 65534, 65535, 65534, 65535, 0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 1, 0, 2, 0, 4, 0, 7, 1, 11, 1, 16,
+		3, 21, 6, 27, 12, 35, 22, 45, 37, 60, 59, 83, 90, 119, 134, 174,
+		190, 258, 262, 382, 350, 561, 455, 810, 577, 1149, 716, 1595, 872, 2167, 1044, 2883,
+		1234, 3757, 1443, 4797, 1675, 6008, 1934, 7385, 2228, 8919, 2567, 10593, 2961, 12387, 3424, 14273,
+		3970, 16224, 4614, 18210, 5372, 20203, 6258, 22178, 7286, 24114, 8465, 25993, 9804, 27804, 11305, 29539,
+		12967, 31198, 14786, 32781, 16750, 34296, 18846, 35748, 21053, 37147, 23349, 38503, 25711, 39824, 28111, 41120,
+		30522, 42396, 32919, 43657, 35275, 44908, 37570, 46148, 39782, 47377, 41895, 48593, 43896, 49792, 45777, 50969,
+		47531, 52120, 49156, 53238, 50652, 54317, 52024, 55353, 53275, 56340, 54413, 57273, 55445, 58150, 56380, 58968,
+		57227, 59725, 57993, 60421, 58687, 61056, 59318, 61631, 59891, 62149, 60413, 62612, 60890, 63023, 61327, 63386,
+		61727, 63703, 62095, 63980, 62434, 64220, 62745, 64427, 63031, 64603, 63294, 64754, 63536, 64882, 63757, 64990,
+		63959, 65081, 64143, 65158, 64310, 65221, 64461, 65274, 64597, 65319, 64719, 65355, 64828, 65386, 64924, 65411,
+		65010, 65431, 65086, 65449, 65152, 65463, 65210, 65475, 65260, 65485, 65303, 65493, 65341, 65500, 65373, 65505,
+		65400, 65510, 65423, 65514, 65442, 65518, 65459, 65520, 65472, 65523, 65484, 65525, 65493, 65526, 65501, 65528,
+		65507, 65529, 65513, 65530, 65517, 65531, 65520, 65532, 65523, 65532, 65526, 65533, 65527, 65533, 65529, 65533,
+		65530, 65534, 65531, 65534, 65532, 65534, 65533, 65534, 65533, 65534, 65533, 65535, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0, 0, 1, 0, 1, 0, 3, 0, 5,
+		0, 7, 1, 10, 2, 14, 5, 19, 9, 25, 17, 32, 28, 45, 46, 64,
+		70, 94, 104, 142, 148, 216, 204, 326, 272, 485, 355, 708, 451, 1011, 561, 1411,
+		685, 1928, 824, 2575, 978, 3367, 1150, 4312, 1343, 5414, 1564, 6671, 1818, 8074, 2116, 9609,
+		2469, 11257, 2889, 12995, 3391, 14797, 3990, 16636, 4701, 18488, 5539, 20330, 6515, 22142, 7642, 23910,
+		8926, 25624, 10372, 27277, 11979, 28868, 13742, 30401, 15651, 31880, 17693, 33313, 19849, 34709, 22098, 36077,
+		24415, 37424, 26776, 38759, 29152, 40088, 31520, 41413, 33854, 42738, 36131, 44061, 38332, 45382, 40441, 46696,
+		42444, 47998, 44332, 49283, 46100, 50542, 47744, 51771, 49266, 52960, 50667, 54104, 51953, 55197, 53129, 56233,
+		54204, 57207, 55184, 58118, 56079, 58963, 56896, 59740, 57643, 60451, 58328, 61095, 58956, 61676, 59534, 62197,
+		60067, 62659, 60559, 63068, 61015, 63426, 61437, 63739, 61828, 64011, 62190, 64246, 62525, 64447, 62834, 64619,
+		63120, 64765, 63382, 64889, 63623, 64994, 63843, 65082, 64044, 65156, 64225, 65218, 64390, 65270, 64537, 65313,
+		64669, 65349, 64787, 65379, 64891, 65404, 64983, 65425, 65064, 65442, 65135, 65457, 65196, 65469, 65249, 65480,
+		65295, 65488, 65334, 65496, 65367, 65502, 65395, 65507, 65419, 65511, 65440, 65515, 65457, 65518, 65471, 65521,
+		65482, 65523, 65492, 65525, 65500, 65527, 65507, 65528, 65512, 65529, 65516, 65530, 65520, 65531, 65523, 65532,
+		65525, 65532, 65527, 65533, 65529, 65533, 65530, 65534, 65531, 65534, 65532, 65534, 65532, 65534, 65533, 65534,
+		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 1,
+		0, 2, 0, 3, 0, 5, 1, 7, 2, 10, 4, 13, 7, 17, 13, 23,
+		22, 33, 35, 49, 54, 76, 80, 117, 114, 182, 157, 279, 211, 419, 275, 617,
+		350, 887, 437, 1245, 535, 1708, 646, 2290, 770, 3003, 912, 3856, 1073, 4854, 1261, 5993,
+		1482, 7268, 1744, 8666, 2060, 10171, 2442, 11760, 2904, 13413, 3460, 15106, 4125, 16815, 4914, 18522,
+		5839, 20208, 6911, 21862, 8138, 23474, 9524, 25040, 11069, 26560, 12769, 28036, 14615, 29475, 16594, 30884,
+		18689, 32271, 20878, 33645, 23139, 35013, 25447, 36383, 27777, 37758, 30102, 39143, 32400, 40538, 34647, 41942,
+		36826, 43351, 38919, 44760, 40913, 46163, 42800, 47553, 44573, 48921, 46230, 50258, 47770, 51557, 49196, 52810,
+		50512, 54009, 51725, 55147, 52840, 56221, 53866, 57226, 54809, 58159, 55679, 59020, 56481, 59807, 57223, 60523,
+		57910, 61169, 58548, 61748, 59142, 62264, 59695, 62721, 60211, 63122, 60692, 63473, 61141, 63778, 61560, 64042,
+		61949, 64270, 62310, 64464, 62645, 64631, 62955, 64772, 63240, 64892, 63501, 64993, 63739, 65078, 63956, 65150,
+		64152, 65210, 64328, 65261, 64487, 65304, 64628, 65340, 64754, 65370, 64864, 65395, 64962, 65416, 65047, 65434,
+		65121, 65449, 65185, 65462, 65241, 65473, 65288, 65483, 65329, 65491, 65363, 65497, 65392, 65503, 65417, 65508,
+		65438, 65512, 65455, 65516, 65469, 65519, 65481, 65521, 65491, 65524, 65499, 65526, 65506, 65527, 65511, 65528,
+		65516, 65530, 65519, 65531, 65522, 65531, 65525, 65532, 65527, 65533, 65528, 65533, 65530, 65533, 65531, 65534,
+		65531, 65534, 65532, 65534, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 1, 0, 1, 0, 2, 0, 3, 1, 5, 1, 6, 3, 9,
+		5, 12, 10, 17, 16, 25, 27, 39, 41, 61, 61, 98, 87, 154, 121, 239,
+		162, 362, 211, 537, 270, 776, 338, 1095, 415, 1507, 504, 2027, 605, 2665, 721, 3431,
+		856, 4327, 1016, 5354, 1207, 6505, 1440, 7770, 1723, 9133, 2070, 10578, 2494, 12084, 3009, 13631,
+		3630, 15199, 4370, 16770, 5243, 18330, 6259, 19867, 7426, 21375, 8748, 22852, 10227, 24296, 11858, 25712,
+		13633, 27106, 15541, 28485, 17564, 29858, 19684, 31233, 21878, 32616, 24122, 34015, 26392, 35433, 28663, 36872,
+		30913, 38332, 33119, 39811, 35263, 41303, 37330, 42804, 39305, 44304, 41182, 45796, 42952, 47269, 44614, 48714,
+		46166, 50121, 47612, 51480, 48955, 52784, 50201, 54025, 51355, 55197, 52426, 56296, 53419, 57318, 54342, 58262,
+		55201, 59128, 56003, 59915, 56753, 60627, 57455, 61267, 58114, 61837, 58733, 62343, 59314, 62788, 59861, 63179,
+		60373, 63519, 60853, 63814, 61303, 64069, 61722, 64288, 62112, 64475, 62473, 64635, 62806, 64771, 63113, 64887,
+		63394, 64985, 63650, 65068, 63882, 65138, 64091, 65198, 64279, 65248, 64447, 65290, 64596, 65326, 64728, 65357,
+		64844, 65383, 64946, 65405, 65035, 65424, 65112, 65440, 65178, 65454, 65235, 65466, 65284, 65476, 65325, 65485,
+		65361, 65492, 65390, 65499, 65415, 65504, 65436, 65509, 65454, 65513, 65468, 65517, 65480, 65520, 65490, 65522,
+		65498, 65524, 65505, 65526, 65511, 65528, 65515, 65529, 65519, 65530, 65522, 65531, 65524, 65532, 65526, 65532,
+		65528, 65533, 65529, 65533, 65530, 65534, 65531, 65534, 0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0, 0, 1, 0, 1, 0, 2, 0, 3,
+		1, 4, 2, 6, 4, 8, 7, 12, 12, 19, 20, 31, 31, 50, 46, 81,
+		66, 130, 92, 204, 123, 313, 162, 466, 207, 677, 260, 959, 321, 1324, 391, 1785,
+		473, 2353, 568, 3035, 682, 3836, 818, 4755, 985, 5787, 1191, 6923, 1445, 8152, 1760, 9456,
+		2149, 10819, 2625, 12223, 3203, 13652, 3896, 15089, 4716, 16523, 5674, 17944, 6779, 19348, 8035, 20733,
+		9442, 22099, 10999, 23452, 12697, 24797, 14526, 26142, 16470, 27496, 18511, 28866, 20627, 30260, 22797, 31681,
+		24997, 33135, 27203, 34622, 29393, 36142, 31547, 37690, 33647, 39260, 35677, 40847, 37624, 42439, 39481, 44028,
+		41241, 45602, 42900, 47150, 44459, 48660, 45920, 50124, 47286, 51530, 48562, 52871, 49754, 54140, 50868, 55332,
+		51910, 56442, 52888, 57469, 53807, 58412, 54671, 59271, 55487, 60049, 56257, 60750, 56985, 61375, 57674, 61931,
+		58326, 62422, 58942, 62853, 59523, 63229, 60071, 63557, 60585, 63840, 61067, 64085, 61517, 64295, 61936, 64476,
+		62323, 64630, 62680, 64761, 63007, 64874, 63306, 64969, 63578, 65051, 63823, 65120, 64044, 65179, 64242, 65230,
+		64418, 65273, 64573, 65310, 64711, 65341, 64831, 65369, 64936, 65392, 65027, 65412, 65106, 65430, 65174, 65445,
+		65232, 65458, 65281, 65469, 65323, 65479, 65359, 65487, 65389, 65494, 65414, 65500, 65435, 65506, 65453, 65510,
+		65467, 65514, 65479, 65518, 65489, 65521, 65497, 65523, 65504, 65525, 65510, 65527, 65514, 65528, 65518, 65529,
+		65521, 65530, 65524, 65531, 65526, 65532, 65527, 65533, 65529, 65533, 65530, 65533, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 1,
+		0, 1, 0, 2, 1, 3, 2, 4, 3, 6, 5, 9, 9, 15, 15, 25,
+		23, 41, 35, 68, 50, 110, 69, 175, 93, 269, 123, 403, 157, 588, 199, 835,
+		246, 1157, 303, 1564, 369, 2066, 447, 2671, 543, 3381, 660, 4198, 805, 5117, 987, 6131,
+		1216, 7230, 1502, 8399, 1858, 9625, 2297, 10892, 2833, 12185, 3479, 13492, 4247, 14802, 5147, 16109,
+		6188, 17409, 7374, 18702, 8707, 19989, 10185, 21276, 11801, 22569, 13545, 23877, 15403, 25207, 17357, 26568,
+		19387, 27965, 21474, 29404, 23593, 30888, 25724, 32416, 27845, 33988, 29937, 35598, 31982, 37240, 33966, 38905,
+		35876, 40583, 37705, 42263, 39446, 43932, 41097, 45577, 42657, 47187, 44128, 48750, 45512, 50254, 46815, 51692,
+		48042, 53054, 49199, 54336, 50291, 55532, 51323, 56640, 52302, 57659, 53231, 58590, 54115, 59434, 54956, 60195,
+		55757, 60876, 56521, 61482, 57247, 62019, 57938, 62492, 58593, 62906, 59213, 63267, 59798, 63581, 60348, 63853,
+		60863, 64087, 61343, 64290, 61788, 64463, 62200, 64612, 62578, 64741, 62925, 64850, 63240, 64944, 63525, 65025,
+		63782, 65095, 64012, 65155, 64217, 65206, 64399, 65251, 64559, 65289, 64700, 65323, 64824, 65352, 64931, 65377,
+		65023, 65399, 65103, 65418, 65172, 65434, 65230, 65449, 65280, 65461, 65322, 65472, 65358, 65481, 65388, 65489,
+		65413, 65496, 65434, 65502, 65451, 65508, 65466, 65512, 65478, 65516, 65488, 65519, 65496, 65522, 65503, 65524,
+		65508, 65526, 65513, 65528, 65517, 65529, 65520, 65530, 65523, 65531, 65525, 65532, 65527, 65532, 65528, 65533,
+		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 1, 0, 1, 1, 2, 1, 3, 2, 4, 4, 7,
+		7, 12, 11, 20, 17, 34, 26, 57, 37, 93, 52, 149, 70, 231, 92, 348,
+		119, 509, 151, 725, 188, 1006, 233, 1363, 287, 1804, 352, 2336, 432, 2962, 533, 3683,
+		660, 4497, 821, 5396, 1027, 6372, 1286, 7414, 1612, 8509, 2016, 9644, 2511, 10807, 3111, 11988,
+		3827, 13179, 4669, 14375, 5645, 15573, 6760, 16774, 8016, 17982, 9412, 19202, 10941, 20442, 12595, 21709,
+		14360, 23012, 16221, 24358, 18158, 25753, 20153, 27203, 22184, 28709, 24232, 30272, 26275, 31888, 28295, 33553,
+		30277, 35258, 32206, 36995, 34071, 38751, 35864, 40514, 37580, 42270, 39215, 44006, 40770, 45709, 42245, 47365,
+		43645, 48962, 44972, 50491, 46231, 51943, 47428, 53311, 48568, 54590, 49656, 55776, 50696, 56870, 51691, 57870,
+		52645, 58779, 53560, 59599, 54437, 60336, 55278, 60993, 56083, 61576, 56853, 62091, 57586, 62543, 58283, 62940,
+		58943, 63285, 59565, 63586, 60149, 63847, 60695, 64072, 61204, 64268, 61674, 64436, 62107, 64582, 62504, 64708,
+		62866, 64816, 63195, 64911, 63491, 64992, 63756, 65063, 63993, 65125, 64204, 65178, 64390, 65225, 64553, 65266,
+		64697, 65301, 64821, 65333, 64929, 65360, 65022, 65384, 65102, 65405, 65171, 65423, 65229, 65439, 65279, 65453,
+		65321, 65465, 65356, 65475, 65386, 65484, 65411, 65492, 65432, 65499, 65449, 65505, 65464, 65510, 65476, 65514,
+		65486, 65517, 65494, 65520, 65501, 65523, 65507, 65525, 65512, 65527, 65516, 65528, 65519, 65530, 65522, 65531,
+		65524, 65531, 65526, 65532, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0, 0, 1, 0, 1, 0, 1, 1, 2,
+		2, 3, 3, 5, 5, 9, 8, 16, 13, 28, 19, 48, 28, 79, 39, 127,
+		52, 197, 69, 298, 90, 438, 114, 625, 144, 870, 179, 1181, 223, 1566, 277, 2031,
+		345, 2579, 431, 3212, 543, 3927, 686, 4719, 870, 5581, 1105, 6502, 1402, 7474, 1773, 8485,
+		2229, 9525, 2784, 10587, 3448, 11664, 4232, 12752, 5143, 13851, 6186, 14963, 7364, 16093, 8675, 17246,
+		10115, 18430, 11674, 19653, 13342, 20925, 15104, 22252, 16942, 23640, 18839, 25094, 20775, 26616, 22731, 28205,
+		24688, 29858, 26630, 31569, 28540, 33329, 30407, 35128, 32219, 36953, 33969, 38791, 35653, 40627, 37267, 42446,
+		38811, 44233, 40286, 45975, 41696, 47658, 43043, 49273, 44333, 50808, 45569, 52257, 46756, 53615, 47898, 54876,
+		48998, 56041, 50060, 57108, 51086, 58080, 52076, 58960, 53032, 59751, 53953, 60458, 54840, 61088, 55692, 61646,
+		56507, 62138, 57284, 62570, 58023, 62949, 58721, 63280, 59379, 63568, 59995, 63820, 60570, 64038, 61103, 64228,
+		61595, 64394, 62046, 64537, 62458, 64663, 62833, 64772, 63171, 64868, 63474, 64951, 63746, 65025, 63987, 65089,
+		64201, 65146, 64389, 65195, 64554, 65239, 64697, 65278, 64822, 65312, 64930, 65342, 65023, 65368, 65102, 65391,
+		65170, 65411, 65228, 65429, 65277, 65444, 65319, 65458, 65354, 65469, 65384, 65479, 65408, 65488, 65429, 65496,
+		65447, 65502, 65461, 65507, 65473, 65512, 65483, 65516, 65492, 65519, 65499, 65522, 65505, 65524, 65510, 65526,
+		65514, 65528, 65517, 65529, 65520, 65530, 65523, 65531, 0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 1,
+		0, 1, 1, 1, 1, 2, 2, 4, 4, 7, 6, 13, 9, 23, 14, 40,
+		20, 67, 29, 107, 39, 168, 52, 255, 67, 375, 86, 537, 109, 748, 138, 1017,
+		173, 1351, 218, 1755, 276, 2232, 351, 2784, 448, 3408, 576, 4101, 741, 4856, 953, 5667,
+		1223, 6524, 1562, 7419, 1981, 8344, 2492, 9293, 3106, 10261, 3832, 11247, 4677, 12252, 5648, 13278,
+		6747, 14330, 7972, 15416, 9319, 16544, 10782, 17722, 12350, 18959, 14008, 20263, 15743, 21639, 17537, 23091,
+		19372, 24622, 21231, 26230, 23096, 27911, 24952, 29659, 26784, 31464, 28582, 33316, 30334, 35201, 32036, 37103,
+		33681, 39009, 35268, 40901, 36796, 42764, 38266, 44584, 39682, 46346, 41046, 48038, 42362, 49651, 43634, 51176,
+		44866, 52606, 46062, 53939, 47222, 55171, 48351, 56302, 49448, 57335, 50515, 58271, 51551, 59116, 52555, 59873,
+		53526, 60550, 54462, 61151, 55361, 61683, 56222, 62152, 57042, 62566, 57820, 62929, 58555, 63247, 59244, 63526,
+		59889, 63770, 60488, 63983, 61041, 64171, 61550, 64335, 62015, 64479, 62438, 64606, 62821, 64718, 63165, 64817,
+		63474, 64904, 63748, 64981, 63991, 65049, 64206, 65110, 64394, 65163, 64558, 65211, 64701, 65253, 64825, 65290,
+		64932, 65323, 65024, 65352, 65102, 65377, 65169, 65399, 65227, 65419, 65275, 65436, 65316, 65451, 65351, 65463,
+		65380, 65475, 65405, 65484, 65425, 65492, 65443, 65499, 65458, 65505, 65470, 65510, 65480, 65514, 65489, 65518,
+		65496, 65521, 65502, 65524, 65507, 65526, 65512, 65527, 65515, 65529, 65519, 65530, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 1, 0, 1, 1, 2, 2, 3, 3, 6, 4, 11,
+		7, 19, 10, 33, 15, 56, 21, 91, 29, 142, 38, 216, 50, 319, 65, 458,
+		83, 640, 106, 871, 134, 1159, 172, 1507, 221, 1920, 286, 2397, 372, 2939, 485, 3541,
+		633, 4199, 824, 4907, 1069, 5659, 1378, 6446, 1761, 7264, 2229, 8108, 2794, 8975, 3463, 9865,
+		4244, 10779, 5143, 11722, 6162, 12700, 7301, 13721, 8555, 14792, 9920, 15924, 11385, 17124, 12938, 18400,
+		14566, 19758, 16252, 21203, 17982, 22735, 19739, 24353, 21507, 26054, 23272, 27830, 25021, 29671, 26744, 31566,
+		28432, 33499, 30078, 35457, 31679, 37422, 33234, 39377, 34741, 41307, 36202, 43195, 37619, 45026, 38996, 46789,
+		40336, 48472, 41642, 50065, 42916, 51563, 44163, 52961, 45383, 54256, 46577, 55448, 47746, 56538, 48889, 57530,
+		50006, 58426, 51093, 59233, 52149, 59955, 53171, 60600, 54157, 61173, 55103, 61680, 56007, 62130, 56867, 62527,
+		57681, 62876, 58446, 63185, 59163, 63457, 59830, 63697, 60448, 63909, 61016, 64096, 61537, 64262, 62011, 64409,
+		62441, 64540, 62828, 64656, 63175, 64759, 63485, 64851, 63759, 64933, 64002, 65006, 64215, 65071, 64402, 65129,
+		64564, 65181, 64706, 65227, 64828, 65268, 64933, 65304, 65023, 65335, 65101, 65363, 65167, 65388, 65223, 65409,
+		65271, 65428, 65311, 65444, 65346, 65458, 65375, 65470, 65400, 65480, 65420, 65489, 65438, 65497, 65453, 65503,
+		65466, 65509, 65476, 65513, 65485, 65517, 65493, 65520, 65499, 65523, 65505, 65525, 65509, 65527, 65513, 65528,
+		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 1, 1, 1, 1, 2,
+		2, 5, 3, 9, 5, 16, 8, 28, 11, 47, 15, 76, 21, 120, 28, 183,
+		37, 270, 48, 389, 63, 544, 81, 742, 105, 988, 136, 1287, 178, 1641, 234, 2052,
+		310, 2518, 410, 3039, 542, 3608, 714, 4223, 936, 4878, 1216, 5567, 1564, 6286, 1992, 7033,
+		2509, 7806, 3123, 8606, 3841, 9435, 4669, 10300, 5609, 11207, 6661, 12163, 7823, 13179, 9088, 14262,
+		10450, 15423, 11896, 16668, 13415, 18004, 14993, 19435, 16614, 20962, 18266, 22583, 19933, 24294, 21603, 26089,
+		23265, 27955, 24908, 29882, 26526, 31854, 28113, 33856, 29666, 35869, 31183, 37877, 32665, 39862, 34112, 41809,
+		35527, 43700, 36913, 45524, 38272, 47268, 39608, 48923, 40923, 50482, 42218, 51939, 43495, 53292, 44753, 54541,
+		45993, 55685, 47212, 56729, 48408, 57676, 49578, 58530, 50719, 59298, 51828, 59987, 52901, 60601, 53934, 61148,
+		54923, 61635, 55866, 62068, 56760, 62452, 57604, 62792, 58394, 63095, 59132, 63364, 59816, 63603, 60446, 63816,
+		61025, 64006, 61552, 64176, 62030, 64328, 62462, 64464, 62850, 64586, 63196, 64696, 63504, 64794, 63776, 64882,
+		64016, 64961, 64226, 65032, 64410, 65095, 64570, 65151, 64709, 65201, 64828, 65245, 64932, 65285, 65020, 65319,
+		65096, 65350, 65161, 65377, 65217, 65400, 65264, 65420, 65304, 65438, 65339, 65453, 65368, 65466, 65393, 65477,
+		65414, 65486, 65432, 65495, 65447, 65501, 65460, 65507, 65471, 65512, 65481, 65516, 65489, 65519, 65496, 65522,
+		65502, 65525, 65507, 65527, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+		0, 1, 1, 2, 1, 4, 2, 7, 4, 13, 5, 23, 8, 39, 11, 64,
+		15, 101, 21, 154, 27, 228, 36, 328, 47, 459, 62, 628, 82, 837, 108, 1092,
+		144, 1394, 193, 1745, 259, 2145, 348, 2591, 466, 3081, 620, 3612, 819, 4179, 1072, 4779,
+		1389, 5408, 1778, 6066, 2248, 6752, 2808, 7469, 3465, 8220, 4223, 9011, 5086, 9849, 6053, 10744,
+		7123, 11704, 8290, 12740, 9548, 13859, 10888, 15070, 12297, 16379, 13764, 17791, 15277, 19305, 16821, 20921,
+		18385, 22635, 19958, 24438, 21529, 26320, 23089, 28268, 24634, 30267, 26158, 32301, 27658, 34352, 29134, 36401,
+		30586, 38431, 32015, 40425, 33423, 42367, 34814, 44242, 36190, 46039, 37552, 47748, 38903, 49360, 40244, 50870,
+		41575, 52276, 42895, 53576, 44203, 54772, 45495, 55865, 46770, 56859, 48022, 57761, 49248, 58574, 50442, 59306,
+		51602, 59962, 52721, 60550, 53796, 61076, 54823, 61546, 55799, 61966, 56721, 62341, 57586, 62677, 58395, 62978,
+		59146, 63248, 59840, 63490, 60477, 63707, 61059, 63903, 61588, 64080, 62066, 64239, 62496, 64383, 62881, 64513,
+		63223, 64630, 63527, 64735, 63794, 64830, 64030, 64916, 64236, 64992, 64416, 65061, 64573, 65122, 64708, 65176,
+		64825, 65224, 64927, 65267, 65014, 65304, 65088, 65337, 65152, 65366, 65207, 65391, 65255, 65413, 65295, 65432,
+		65329, 65448, 65359, 65462, 65384, 65474, 65406, 65484, 65424, 65493, 65440, 65500, 65454, 65506, 65466, 65511,
+		65476, 65515, 65484, 65519, 65492, 65522, 65498, 65524, 0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 1, 1, 2, 1, 3, 2, 6, 3, 11, 4, 19,
+		6, 32, 8, 53, 11, 84, 15, 128, 20, 191, 27, 275, 36, 386, 48, 528,
+		64, 705, 87, 920, 117, 1177, 160, 1475, 218, 1815, 296, 2196, 401, 2615, 539, 3070,
+		718, 3559, 945, 4078, 1231, 4627, 1582, 5204, 2009, 5811, 2517, 6451, 3114, 7129, 3805, 7851,
+		4592, 8625, 5477, 9460, 6456, 10366, 7527, 11353, 8684, 12430, 9917, 13604, 11218, 14883, 12575, 16269,
+		13978, 17765, 15414, 19369, 16875, 21076, 18348, 22878, 19826, 24765, 21302, 26724, 22771, 28739, 24228, 30794,
+		25673, 32870, 27104, 34949, 28522, 37013, 29929, 39044, 31327, 41025, 32719, 42942, 34107, 44782, 35492, 46535,
+		36876, 48193, 38258, 49750, 39640, 51203, 41018, 52550, 42391, 53792, 43754, 54931, 45104, 55972, 46436, 56918,
+		47744, 57776, 49024, 58551, 50268, 59250, 51473, 59880, 52633, 60446, 53743, 60956, 54800, 61415, 55800, 61827,
+		56741, 62199, 57622, 62535, 58441, 62838, 59198, 63113, 59895, 63361, 60533, 63587, 61113, 63791, 61638, 63977,
+		62112, 64146, 62536, 64299, 62914, 64437, 63250, 64563, 63548, 64676, 63810, 64779, 64040, 64871, 64241, 64954,
+		64417, 65028, 64570, 65094, 64702, 65153, 64817, 65205, 64916, 65251, 65002, 65291, 65076, 65326, 65140, 65357,
+		65194, 65384, 65242, 65407, 65282, 65427, 65317, 65444, 65348, 65459, 65374, 65471, 65396, 65482, 65416, 65491,
+		65432, 65498, 65447, 65505, 65459, 65510, 65470, 65514, 65479, 65518, 65488, 65521, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0, 0, 1, 0, 1, 1, 2, 1, 5,
+		2, 9, 3, 16, 4, 27, 6, 44, 8, 70, 11, 107, 15, 159, 20, 229,
+		27, 322, 37, 441, 51, 590, 69, 771, 96, 987, 133, 1239, 184, 1526, 253, 1849,
+		346, 2206, 469, 2595, 628, 3013, 832, 3461, 1088, 3937, 1405, 4441, 1789, 4977, 2248, 5547,
+		2789, 6158, 3415, 6816, 4129, 7529, 4933, 8307, 5825, 9161, 6802, 10099, 7859, 11132, 8988, 12267,
+		10182, 13511, 11431, 14868, 12725, 16339, 14055, 17923, 15411, 19615, 16785, 21407, 18170, 23290, 19560, 25249,
+		20951, 27269, 22340, 29333, 23726, 31423, 25110, 33520, 26491, 35606, 27873, 37662, 29257, 39672, 30646, 41620,
+		32041, 43495, 33445, 45284, 34857, 46980, 36278, 48576, 37705, 50069, 39138, 51458, 40571, 52742, 42002, 53924,
+		43424, 55008, 44832, 55997, 46220, 56898, 47581, 57717, 48908, 58459, 50196, 59132, 51439, 59741, 52631, 60292,
+		53767, 60792, 54845, 61245, 55861, 61656, 56812, 62030, 57699, 62371, 58521, 62681, 59278, 62964, 59972, 63222,
+		60604, 63458, 61177, 63674, 61695, 63871, 62160, 64050, 62575, 64214, 62945, 64362, 63273, 64497, 63563, 64619,
+		63819, 64730, 64043, 64829, 64239, 64918, 64410, 64998, 64560, 65068, 64689, 65131, 64802, 65187, 64900, 65236,
+		64985, 65279, 65059, 65316, 65122, 65349, 65178, 65377, 65225, 65402, 65267, 65423, 65303, 65441, 65334, 65456,
+		65361, 65469, 65385, 65480, 65405, 65489, 65423, 65497, 65439, 65504, 65452, 65509, 65464, 65514, 65474, 65518,
+		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 1,
+		0, 2, 1, 4, 1, 7, 2, 13, 3, 22, 4, 36, 6, 57, 8, 88,
+		11, 131, 15, 190, 21, 267, 29, 367, 40, 491, 56, 642, 79, 823, 111, 1034,
+		155, 1276, 216, 1548, 298, 1850, 407, 2180, 549, 2537, 731, 2921, 960, 3332, 1243, 3772,
+		1588, 4243, 2001, 4750, 2487, 5299, 3051, 5898, 3696, 6555, 4422, 7279, 5230, 8082, 6116, 8972,
+		7077, 9960, 8105, 11054, 9195, 12260, 10338, 13582, 11526, 15023, 12751, 16580, 14005, 18249, 15281, 20023,
+		16573, 21890, 17877, 23839, 19189, 25853, 20509, 27916, 21835, 30008, 23168, 32112, 24510, 34208, 25863, 36278,
+		27230, 38305, 28612, 40275, 30011, 42173, 31428, 43990, 32864, 45715, 34317, 47344, 35787, 48871, 37268, 50296,
+		38758, 51618, 40251, 52839, 41741, 53963, 43221, 54994, 44684, 55938, 46123, 56799, 47530, 57585, 48898, 58301,
+		50220, 58954, 51491, 59550, 52705, 60093, 53858, 60589, 54947, 61043, 55969, 61459, 56923, 61840, 57808, 62190,
+		58624, 62511, 59374, 62806, 60058, 63077, 60680, 63327, 61243, 63555, 61749, 63765, 62203, 63956, 62608, 64131,
+		62968, 64290, 63287, 64435, 63569, 64566, 63817, 64684, 64036, 64790, 64227, 64886, 64394, 64971, 64541, 65046,
+		64669, 65113, 64780, 65172, 64877, 65224, 64962, 65269, 65036, 65308, 65101, 65342, 65157, 65372, 65206, 65397,
+		65249, 65419, 65286, 65438, 65319, 65454, 65348, 65467, 65373, 65479, 65394, 65488, 65413, 65496, 65430, 65503,
+		65445, 65508, 65457, 65513, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 1, 0, 2, 1, 3, 1, 6, 1, 10, 2, 18, 3, 30,
+		4, 47, 6, 73, 8, 108, 11, 157, 16, 221, 22, 303, 32, 406, 46, 532,
+		65, 682, 93, 858, 132, 1060, 185, 1288, 257, 1542, 353, 1821, 479, 2124, 640, 2452,
+		844, 2807, 1096, 3189, 1404, 3603, 1773, 4053, 2208, 4546, 2713, 5090, 3292, 5694, 3945, 6367,
+		4673, 7120, 5472, 7963, 6340, 8906, 7272, 9957, 8262, 11122, 9302, 12406, 10388, 13810, 11510, 15334,
+		12664, 16973, 13843, 18720, 15044, 20563, 16262, 22492, 17497, 24489, 18746, 26539, 20011, 28622, 21293, 30721,
+		22593, 32816, 23915, 34889, 25260, 36923, 26631, 38904, 28030, 40817, 29456, 42651, 30911, 44398, 32392, 46051,
+		33898, 47606, 35423, 49061, 36964, 50416, 38514, 51673, 40066, 52835, 41613, 53905, 43146, 54890, 44658, 55794,
+		46139, 56624, 47583, 57385, 48981, 58084, 50328, 58725, 51616, 59314, 52842, 59857, 54002, 60357, 55091, 60818,
+		56110, 61243, 57057, 61636, 57933, 62000, 58738, 62336, 59475, 62646, 60145, 62933, 60753, 63197, 61301, 63440,
+		61794, 63663, 62235, 63867, 62628, 64054, 62977, 64224, 63287, 64378, 63561, 64518, 63803, 64644, 64016, 64757,
+		64203, 64858, 64368, 64947, 64512, 65027, 64639, 65097, 64750, 65159, 64848, 65213, 64933, 65261, 65009, 65302,
+		65074, 65337, 65132, 65368, 65183, 65394, 65228, 65416, 65267, 65436, 65302, 65452, 65332, 65466, 65359, 65477,
+		65382, 65487, 65403, 65495, 65421, 65502, 65437, 65508, 0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 1, 0, 1, 0, 3, 1, 5, 1, 9,
+		1, 15, 2, 24, 3, 38, 4, 59, 6, 88, 9, 128, 12, 181, 18, 249,
+		26, 334, 37, 437, 54, 562, 78, 708, 112, 876, 158, 1066, 222, 1278, 306, 1513,
+		417, 1769, 559, 2049, 740, 2353, 963, 2685, 1236, 3047, 1564, 3447, 1951, 3889, 2402, 4383,
+		2918, 4938, 3502, 5562, 4154, 6268, 4871, 7065, 5652, 7962, 6491, 8968, 7385, 10090, 8328, 11331,
+		9314, 12695, 10338, 14180, 11395, 15781, 12481, 17493, 13592, 19304, 14727, 21202, 15884, 23173, 17063, 25199,
+		18267, 27263, 19496, 29345, 20753, 31428, 22040, 33494, 23362, 35524, 24718, 37505, 26112, 39423, 27543, 41267,
+		29011, 43027, 30515, 44697, 32051, 46273, 33614, 47753, 35200, 49136, 36800, 50425, 38409, 51621, 40016, 52729,
+		41613, 53753, 43192, 54700, 44743, 55574, 46257, 56381, 47727, 57126, 49144, 57816, 50503, 58454, 51798, 59045,
+		53025, 59594, 54180, 60104, 55262, 60577, 56269, 61018, 57202, 61427, 58061, 61808, 58849, 62161, 59568, 62489,
+		60221, 62793, 60812, 63073, 61344, 63331, 61822, 63569, 62249, 63786, 62630, 63985, 62970, 64165, 63271, 64329,
+		63538, 64477, 63774, 64609, 63983, 64728, 64168, 64834, 64331, 64928, 64474, 65012, 64601, 65085, 64713, 65149,
+		64812, 65205, 64899, 65254, 64976, 65296, 65044, 65333, 65105, 65364, 65158, 65391, 65205, 65414, 65247, 65433,
+		65284, 65450, 65316, 65464, 65345, 65476, 65370, 65486, 65392, 65494, 65412, 65501, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 1, 0, 2,
+		0, 4, 1, 7, 1, 12, 1, 20, 2, 31, 3, 48, 4, 72, 7, 104,
+		10, 147, 14, 203, 21, 272, 30, 358, 45, 460, 66, 580, 95, 719, 135, 877,
+		191, 1054, 265, 1250, 362, 1466, 487, 1704, 646, 1964, 843, 2251, 1084, 2569, 1373, 2923,
+		1716, 3320, 2115, 3768, 2574, 4277, 3094, 4856, 3674, 5516, 4314, 6267, 5012, 7118, 5764, 8079,
+		6567, 9155, 7417, 10351, 8309, 11669, 9239, 13109, 10203, 14667, 11199, 16336, 12224, 18107, 13278, 19966,
+		14359, 21901, 15470, 23893, 16612, 25927, 17787, 27983, 19000, 30043, 20251, 32090, 21545, 34107, 22884, 36079,
+		24268, 37992, 25699, 39836, 27176, 41601, 28696, 43281, 30256, 44871, 31851, 46370, 33474, 47777, 35119, 49093,
+		36776, 50321, 38438, 51464, 40093, 52527, 41732, 53515, 43345, 54433, 44924, 55286, 46459, 56080, 47942, 56820,
+		49367, 57509, 50727, 58152, 52017, 58753, 53234, 59315, 54376, 59841, 55441, 60332, 56429, 60792, 57342, 61221,
+		58180, 61622, 58947, 61995, 59645, 62342, 60278, 62663, 60850, 62960, 61365, 63234, 61828, 63485, 62242, 63715,
+		62612, 63925, 62942, 64115, 63236, 64287, 63498, 64442, 63730, 64581, 63936, 64706, 64119, 64816, 64282, 64914,
+		64426, 65000, 64555, 65075, 64669, 65141, 64770, 65199, 64860, 65249, 64940, 65292, 65011, 65329, 65075, 65361,
+		65131, 65389, 65181, 65412, 65225, 65432, 65265, 65448, 65299, 65462, 65330, 65474, 65357, 65484, 65381, 65493,
+		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+		0, 1, 0, 2, 0, 3, 0, 6, 1, 9, 1, 16, 2, 25, 2, 39,
+		3, 58, 5, 85, 7, 119, 11, 165, 17, 221, 25, 291, 37, 375, 55, 473,
+		80, 587, 116, 717, 164, 864, 228, 1028, 313, 1209, 422, 1410, 561, 1633, 734, 1881,
+		945, 2159, 1200, 2473, 1502, 2829, 1854, 3235, 2259, 3702, 2718, 4238, 3232, 4854, 3800, 5560,
+		4421, 6366, 5092, 7280, 5810, 8308, 6572, 9456, 7376, 10726, 8217, 12116, 9093, 13625, 10003, 15244,
+		10945, 16967, 11920, 18779, 12929, 20669, 13972, 22619, 15053, 24612, 16175, 26632, 17342, 28659, 18555, 30678,
+		19820, 32671, 21137, 34624, 22508, 36524, 23934, 38359, 25414, 40121, 26944, 41804, 28522, 43402, 30141, 44915,
+		31796, 46340, 33477, 47680, 35176, 48936, 36883, 50112, 38589, 51212, 40281, 52240, 41951, 53203, 43587, 54103,
+		45181, 54947, 46724, 55738, 48209, 56481, 49628, 57178, 50977, 57835, 52253, 58452, 53451, 59033, 54571, 59579,
+		55612, 60093, 56576, 60574, 57463, 61026, 58276, 61448, 59019, 61842, 59694, 62208, 60307, 62547, 60860, 62861,
+		61359, 63150, 61808, 63414, 62210, 63656, 62571, 63876, 62893, 64075, 63182, 64255, 63440, 64416, 63670, 64560,
+		63876, 64688, 64059, 64802, 64223, 64903, 64370, 64991, 64501, 65068, 64619, 65136, 64723, 65194, 64817, 65245,
+		64901, 65289, 64976, 65327, 65043, 65359, 65103, 65386, 65156, 65410, 65203, 65430, 65245, 65446, 65283, 65461,
+		65316, 65473, 65345, 65483, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 1, 0, 1, 0, 2, 0, 4, 0, 8, 1, 13,
+		1, 20, 2, 31, 3, 47, 4, 68, 6, 96, 9, 133, 14, 179, 21, 235,
+		31, 303, 46, 384, 68, 477, 99, 584, 140, 705, 196, 841, 270, 993, 365, 1163,
+		486, 1353, 636, 1568, 821, 1811, 1044, 2088, 1308, 2407, 1617, 2776, 1972, 3203, 2376, 3699,
+		2829, 4273, 3331, 4936, 3880, 5696, 4475, 6563, 5114, 7542, 5795, 8639, 6515, 9856, 7273, 11193,
+		8066, 12647, 8895, 14212, 9759, 15879, 10659, 17637, 11596, 19473, 12574, 21372, 13596, 23317, 14665, 25291,
+		15785, 27277, 16959, 29257, 18191, 31218, 19484, 33143, 20838, 35020, 22254, 36839, 23731, 38591, 25266, 40269,
+		26855, 41869, 28492, 43389, 30170, 44828, 31880, 46187, 33613, 47469, 35358, 48675, 37105, 49810, 38843, 50879,
+		40561, 51885, 42248, 52833, 43894, 53727, 45490, 54571, 47029, 55369, 48502, 56124, 49905, 56838, 51234, 57514,
+		52484, 58154, 53656, 58758, 54747, 59329, 55760, 59867, 56694, 60373, 57553, 60848, 58340, 61293, 59058, 61707,
+		59711, 62093, 60303, 62449, 60839, 62779, 61323, 63081, 61759, 63358, 62152, 63610, 62505, 63838, 62823, 64045,
+		63109, 64230, 63365, 64397, 63595, 64545, 63803, 64677, 63989, 64793, 64156, 64895, 64307, 64985, 64442, 65063,
+		64564, 65132, 64673, 65191, 64772, 65242, 64860, 65286, 64939, 65324, 65010, 65356, 65074, 65384, 65131, 65407,
+		65181, 65427, 65226, 65444, 65266, 65459, 65302, 65471, 0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0, 0, 1, 0, 1, 0, 2, 0, 3,
+		0, 6, 1, 10, 1, 16, 1, 25, 2, 37, 3, 54, 5, 77, 7, 106,
+		11, 143, 17, 189, 26, 244, 39, 309, 58, 385, 84, 473, 120, 572, 168, 685,
+		231, 812, 314, 955, 419, 1118, 549, 1303, 710, 1516, 903, 1761, 1133, 2047, 1403, 2381,
+		1713, 2773, 2067, 3230, 2463, 3764, 2904, 4384, 3387, 5100, 3913, 5919, 4479, 6848, 5084, 7892,
+		5728, 9054, 6407, 10334, 7123, 11728, 7876, 13233, 8665, 14839, 9494, 16537, 10364, 18313, 11279, 20152,
+		12243, 22040, 13259, 23960, 14332, 25895, 15466, 27830, 16664, 29748, 17929, 31637, 19262, 33484, 20664, 35279,
+		22133, 37013, 23666, 38680, 25259, 40276, 26906, 41798, 28600, 43246, 30331, 44621, 32089, 45924, 33865, 47158,
+		35646, 48327, 37421, 49434, 39178, 50483, 40908, 51479, 42598, 52424, 44241, 53323, 45826, 54178, 47347, 54991,
+		48799, 55766, 50176, 56503, 51475, 57204, 52694, 57870, 53832, 58502, 54891, 59100, 55871, 59664, 56774, 60196,
+		57604, 60694, 58363, 61161, 59057, 61595, 59689, 61998, 60263, 62371, 60783, 62714, 61255, 63028, 61682, 63315,
+		62068, 63576, 62417, 63812, 62733, 64024, 63018, 64214, 63275, 64384, 63508, 64535, 63719, 64669, 63910, 64787,
+		64082, 64890, 64238, 64981, 64379, 65060, 64506, 65129, 64621, 65188, 64725, 65239, 64818, 65283, 64902, 65321,
+		64978, 65353, 65045, 65381, 65106, 65405, 65160, 65425, 65208, 65442, 65251, 65456, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 1,
+		0, 2, 0, 3, 0, 5, 0, 8, 1, 13, 1, 20, 1, 30, 2, 43,
+		4, 61, 6, 85, 9, 114, 14, 151, 22, 195, 33, 248, 49, 310, 71, 381,
+		102, 462, 143, 556, 198, 662, 269, 782, 359, 921, 472, 1081, 610, 1267, 778, 1485,
+		977, 1741, 1211, 2043, 1480, 2401, 1788, 2823, 2134, 3318, 2519, 3897, 2943, 4568, 3404, 5339,
+		3904, 6217, 4440, 7207, 5012, 8312, 5620, 9532, 6264, 10864, 6945, 12304, 7665, 13844, 8427, 15475,
+		9233, 17184, 10087, 18958, 10994, 20782, 11959, 22640, 12985, 24517, 14077, 26397, 15239, 28267, 16474, 30112,
+		17782, 31921, 19165, 33684, 20620, 35394, 22146, 37043, 23737, 38629, 25387, 40148, 27088, 41601, 28832, 42987,
+		30607, 44308, 32404, 45567, 34210, 46767, 36014, 47911, 37803, 49003, 39567, 50046, 41294, 51043, 42975, 51997,
+		44600, 52910, 46163, 53784, 47656, 54621, 49076, 55422, 50419, 56188, 51681, 56918, 52864, 57613, 53966, 58274,
+		54988, 58900, 55934, 59491, 56806, 60047, 57607, 60568, 58342, 61055, 59013, 61508, 59626, 61927, 60185, 62313,
+		60694, 62668, 61156, 62992, 61577, 63287, 61960, 63554, 62308, 63795, 62624, 64012, 62912, 64205, 63173, 64377,
+		63411, 64530, 63628, 64665, 63824, 64783, 64003, 64887, 64166, 64978, 64313, 65057, 64447, 65125, 64568, 65185,
+		64678, 65236, 64777, 65280, 64866, 65317, 64946, 65350, 65018, 65377, 65083, 65401, 65140, 65421, 65191, 65438,
+		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 1, 0, 1, 0, 2, 0, 4, 0, 6, 0, 10, 1, 16,
+		1, 23, 2, 34, 3, 49, 5, 67, 7, 91, 12, 120, 18, 155, 27, 198,
+		41, 248, 60, 305, 86, 372, 122, 449, 168, 537, 229, 639, 306, 757, 403, 895,
+		522, 1058, 666, 1251, 838, 1481, 1040, 1755, 1273, 2081, 1539, 2469, 1839, 2928, 2174, 3466,
+		2543, 4094, 2947, 4817, 3386, 5644, 3859, 6579, 4366, 7625, 4908, 8782, 5486, 10048, 6101, 11420,
+		6756, 12890, 7455, 14449, 8200, 16087, 8997, 17789, 9850, 19542, 10763, 21332, 11743, 23144, 12793, 24964,
+		13917, 26777, 15119, 28571, 16399, 30335, 17758, 32061, 19195, 33739, 20707, 35365, 22289, 36935, 23934, 38447,
+		25636, 39899, 27384, 41293, 29168, 42629, 30978, 43911, 32800, 45140, 34623, 46319, 36435, 47452, 38225, 48542,
+		39980, 49590, 41691, 50600, 43350, 51572, 44947, 52508, 46477, 53409, 47934, 54275, 49314, 55107, 50616, 55904,
+		51838, 56666, 52981, 57392, 54044, 58082, 55031, 58735, 55944, 59351, 56786, 59930, 57561, 60472, 58273, 60977,
+		58926, 61445, 59524, 61878, 60071, 62275, 60572, 62639, 61030, 62971, 61448, 63272, 61831, 63544, 62181, 63788,
+		62502, 64006, 62794, 64201, 63062, 64374, 63307, 64528, 63531, 64662, 63736, 64781, 63923, 64885, 64093, 64975,
+		64248, 65053, 64389, 65122, 64517, 65181, 64633, 65231, 64738, 65275, 64832, 65313, 64917, 65345, 64993, 65373,
+		65061, 65396, 65122, 65417, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0, 0, 1, 0, 2, 0, 3, 0, 5,
+		0, 8, 1, 12, 1, 18, 1, 27, 2, 38, 4, 53, 6, 72, 10, 95,
+		15, 123, 23, 157, 34, 197, 51, 244, 73, 298, 103, 361, 143, 435, 194, 521,
+		260, 622, 343, 741, 445, 884, 568, 1055, 715, 1260, 888, 1508, 1088, 1806, 1318, 2162,
+		1577, 2586, 1867, 3085, 2187, 3670, 2539, 4347, 2923, 5122, 3338, 6001, 3787, 6987, 4268, 8080,
+		4785, 9278, 5340, 10579, 5936, 11975, 6575, 13459, 7264, 15020, 8005, 16646, 8806, 18323, 9670, 20040,
+		10604, 21781, 11611, 23533, 12696, 25284, 13862, 27021, 15110, 28735, 16442, 30416, 17855, 32058, 19348, 33655,
+		20914, 35204, 22548, 36703, 24242, 38151, 25987, 39548, 27771, 40896, 29584, 42197, 31414, 43452, 33248, 44666,
+		35074, 45839, 36881, 46974, 38656, 48073, 40390, 49138, 42074, 50169, 43698, 51168, 45258, 52134, 46746, 53067,
+		48160, 53967, 49497, 54832, 50755, 55662, 51934, 56456, 53036, 57212, 54062, 57930, 55014, 58609, 55896, 59248,
+		56712, 59847, 57464, 60407, 58158, 60927, 58796, 61407, 59384, 61850, 59924, 62256, 60422, 62626, 60879, 62963,
+		61299, 63267, 61686, 63541, 62042, 63787, 62369, 64006, 62670, 64201, 62946, 64374, 63200, 64526, 63433, 64661,
+		63647, 64778, 63843, 64881, 64022, 64971, 64185, 65049, 64333, 65117, 64468, 65175, 64591, 65226, 64701, 65269,
+		64801, 65307, 64890, 65339, 64970, 65367, 65042, 65391, 0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 1, 0, 1,
+		0, 2, 0, 4, 0, 6, 0, 10, 1, 14, 1, 21, 2, 30, 3, 41,
+		5, 56, 8, 75, 12, 97, 19, 124, 29, 156, 42, 194, 61, 238, 86, 290,
+		120, 351, 164, 423, 220, 510, 290, 613, 377, 738, 482, 889, 607, 1074, 755, 1298,
+		926, 1569, 1123, 1895, 1345, 2285, 1595, 2747, 1872, 3290, 2178, 3921, 2512, 4645, 2876, 5468,
+		3271, 6393, 3698, 7421, 4160, 8550, 4659, 9778, 5199, 11098, 5784, 12503, 6419, 13984, 7109, 15529,
+		7859, 17127, 8676, 18765, 9563, 20431, 10527, 22111, 11572, 23794, 12700, 25469, 13914, 27126, 15214, 28759,
+		16599, 30359, 18066, 31922, 19611, 33446, 21227, 34928, 22906, 36367, 24639, 37764, 26415, 39120, 28224, 40437,
+		30052, 41716, 31888, 42961, 33719, 44172, 35534, 45351, 37321, 46501, 39070, 47620, 40771, 48711, 42416, 49772,
+		43998, 50803, 45512, 51804, 46954, 52772, 48320, 53707, 49610, 54607, 50824, 55469, 51961, 56294, 53024, 57078,
+		54014, 57821, 54936, 58523, 55792, 59181, 56585, 59797, 57320, 60371, 58000, 60902, 58630, 61392, 59212, 61842,
+		59750, 62252, 60249, 62626, 60709, 62965, 61135, 63270, 61530, 63544, 61894, 63789, 62231, 64008, 62542, 64202,
+		62829, 64374, 63094, 64525, 63337, 64658, 63561, 64774, 63766, 64876, 63954, 64965, 64125, 65042, 64282, 65109,
+		64424, 65168, 64552, 65218, 64668, 65262, 64773, 65299, 64866, 65332, 64950, 65360, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+		0, 1, 0, 1, 0, 2, 0, 3, 0, 5, 0, 7, 1, 11, 1, 16,
+		2, 23, 3, 32, 4, 44, 7, 58, 10, 76, 16, 97, 24, 123, 35, 153,
+		51, 189, 72, 232, 101, 283, 138, 344, 185, 418, 244, 507, 317, 617, 406, 751,
+		513, 916, 638, 1118, 784, 1364, 952, 1663, 1142, 2021, 1356, 2447, 1595, 2949, 1859, 3534,
+		2149, 4208, 2467, 4976, 2814, 5840, 3192, 6802, 3604, 7861, 4053, 9014, 4542, 10257, 5077, 11582,
+		5662, 12980, 6302, 14442, 7005, 15957, 7774, 17513, 8617, 19099, 9538, 20703, 10541, 22314, 11630, 23922,
+		12806, 25519, 14071, 27097, 15423, 28652, 16860, 30177, 18377, 31670, 19968, 33131, 21624, 34557, 23338, 35951,
+		25098, 37311, 26894, 38641, 28712, 39942, 30541, 41215, 32370, 42462, 34184, 43684, 35975, 44882, 37730, 46055,
+		39441, 47204, 41099, 48327, 42698, 49424, 44231, 50492, 45695, 51529, 47087, 52534, 48405, 53504, 49648, 54437,
+		50818, 55330, 51916, 56182, 52943, 56991, 53903, 57756, 54799, 58476, 55633, 59150, 56410, 59778, 57133, 60361,
+		57806, 60900, 58432, 61395, 59014, 61848, 59556, 62261, 60059, 62635, 60528, 62973, 60963, 63278, 61368, 63550,
+		61744, 63794, 62092, 64010, 62416, 64202, 62715, 64372, 62991, 64521, 63245, 64652, 63480, 64768, 63695, 64868,
+		63892, 64956, 64072, 65033, 64235, 65100, 64384, 65158, 64518, 65208, 64640, 65252, 64749, 65290, 64846, 65323,
+		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 1, 0, 1, 0, 2, 0, 4, 0, 6,
+		0, 9, 1, 13, 1, 18, 2, 25, 3, 34, 5, 45, 9, 59, 13, 76,
+		20, 97, 29, 121, 43, 150, 60, 185, 84, 228, 115, 279, 155, 342, 205, 420,
+		266, 516, 341, 635, 431, 782, 537, 965, 660, 1188, 803, 1460, 965, 1788, 1148, 2180,
+		1353, 2643, 1580, 3183, 1832, 3808, 2109, 4520, 2413, 5324, 2747, 6221, 3113, 7209, 3516, 8288,
+		3959, 9452, 4447, 10695, 4985, 12009, 5580, 13386, 6237, 14816, 6961, 16287, 7759, 17791, 8637, 19315,
+		9597, 20851, 10645, 22389, 11782, 23922, 13008, 25442, 14323, 26946, 15725, 28430, 17208, 29889, 18768, 31325,
+		20395, 32735, 22083, 34121, 23819, 35483, 25593, 36823, 27393, 38142, 29207, 39441, 31024, 40722, 32830, 41984,
+		34616, 43228, 36370, 44453, 38083, 45659, 39748, 46843, 41356, 48003, 42903, 49138, 44383, 50244, 45796, 51319,
+		47137, 52359, 48408, 53362, 49607, 54325, 50737, 55245, 51800, 56120, 52797, 56949, 53732, 57731, 54608, 58464,
+		55428, 59149, 56195, 59785, 56913, 60374, 57584, 60915, 58212, 61412, 58799, 61865, 59348, 62277, 59862, 62649,
+		60341, 62985, 60789, 63287, 61207, 63556, 61596, 63796, 61959, 64010, 62295, 64199, 62607, 64366, 62895, 64514,
+		63161, 64643, 63406, 64757, 63631, 64857, 63837, 64944, 64025, 65020, 64195, 65087, 64350, 65145, 64490, 65196,
+		64616, 65240, 64729, 65278, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 1, 0, 1, 0, 2,
+		0, 3, 0, 4, 0, 7, 1, 10, 1, 14, 2, 19, 3, 26, 4, 35,
+		7, 46, 11, 59, 16, 76, 24, 95, 35, 119, 50, 148, 70, 183, 96, 227,
+		129, 281, 170, 348, 222, 432, 285, 538, 360, 670, 449, 833, 554, 1035, 674, 1283,
+		812, 1582, 967, 1941, 1142, 2366, 1338, 2864, 1555, 3440, 1796, 4098, 2062, 4843, 2356, 5674,
+		2682, 6593, 3043, 7597, 3444, 8682, 3889, 9843, 4384, 11073, 4935, 12364, 5548, 13707, 6229, 15093,
+		6984, 16511, 7818, 17954, 8736, 19412, 9741, 20877, 10835, 22343, 12020, 23803, 13295, 25254, 14656, 26692,
+		16101, 28114, 17624, 29521, 19216, 30912, 20869, 32287, 22574, 33647, 24320, 34994, 26094, 36328, 27885, 37650,
+		29682, 38961, 31472, 40260, 33245, 41548, 34990, 42823, 36699, 44083, 38362, 45327, 39974, 46550, 41528, 47750,
+		43020, 48924, 44448, 50067, 45809, 51177, 47103, 52249, 48329, 53281, 49490, 54269, 50586, 55211, 51619, 56105,
+		52593, 56949, 53510, 57741, 54373, 58483, 55185, 59173, 55949, 59812, 56667, 60402, 57343, 60943, 57978, 61438,
+		58575, 61888, 59136, 62296, 59663, 62664, 60157, 62996, 60620, 63293, 61053, 63559, 61457, 63795, 61834, 64005,
+		62184, 64191, 62509, 64356, 62810, 64501, 63087, 64629, 63342, 64742, 63576, 64841, 63790, 64928, 63985, 65004,
+		64162, 65071, 64322, 65129, 64467, 65181, 64596, 65225, 0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+		0, 1, 0, 1, 0, 2, 0, 3, 0, 5, 0, 7, 1, 11, 1, 15,
+		2, 20, 4, 27, 6, 36, 9, 46, 14, 59, 20, 75, 29, 94, 41, 118,
+		58, 147, 79, 184, 107, 230, 141, 289, 184, 363, 237, 456, 300, 574, 374, 721,
+		462, 903, 563, 1127, 679, 1400, 811, 1727, 960, 2116, 1128, 2572, 1315, 3101, 1524, 3707,
+		1757, 4394, 2016, 5162, 2306, 6012, 2629, 6942, 2992, 7949, 3397, 9028, 3852, 10174, 4362, 11378,
+		4934, 12634, 5573, 13933, 6285, 15267, 7076, 16627, 7950, 18005, 8911, 19396, 9961, 20792, 11102, 22190,
+		12332, 23584, 13650, 24974, 15052, 26357, 16532, 27732, 18083, 29100, 19696, 30461, 21363, 31816, 23073, 33165,
+		24813, 34511, 26574, 35853, 28344, 37191, 30110, 38526, 31863, 39854, 33592, 41176, 35289, 42488, 36945, 43788,
+		38553, 45071, 40109, 46335, 41608, 47574, 43046, 48784, 44423, 49962, 45736, 51103, 46987, 52203, 48175, 53258,
+		49302, 54266, 50371, 55224, 51383, 56129, 52341, 56982, 53247, 57781, 54105, 58525, 54916, 59216, 55683, 59853,
+		56408, 60440, 57093, 60977, 57741, 61467, 58352, 61911, 58928, 62314, 59471, 62676, 59982, 63002, 60461, 63294,
+		60910, 63555, 61330, 63787, 61721, 63994, 62086, 64177, 62424, 64339, 62736, 64483, 63024, 64610, 63289, 64722,
+		63531, 64820, 63752, 64907, 63953, 64984, 64136, 65051, 64300, 65111, 64449, 65163, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 1, 0, 1, 0, 2, 0, 3, 0, 4, 0, 6,
+		1, 8, 1, 11, 2, 15, 3, 21, 5, 27, 7, 36, 11, 46, 16, 59,
+		24, 74, 34, 94, 48, 118, 65, 150, 88, 189, 117, 240, 152, 305, 196, 388,
+		248, 492, 310, 624, 384, 788, 468, 991, 566, 1237, 678, 1535, 804, 1889, 948, 2306,
+		1108, 2790, 1289, 3345, 1493, 3975, 1721, 4682, 1978, 5464, 2268, 6322, 2595, 7252, 2965, 8251,
+		3383, 9313, 3855, 10432, 4387, 11601, 4985, 12814, 5655, 14062, 6403, 15339, 7234, 16637, 8150, 17952,
+		9154, 19278, 10249, 20611, 11432, 21948, 12702, 23287, 14056, 24627, 15489, 25968, 16993, 27310, 18561, 28654,
+		20184, 30001, 21851, 31351, 23551, 32705, 25275, 34063, 27009, 35425, 28745, 36790, 30471, 38156, 32177, 39522,
+		33855, 40882, 35498, 42235, 37097, 43576, 38649, 44899, 40149, 46201, 41594, 47477, 42981, 48720, 44311, 49927,
+		45582, 51093, 46796, 52214, 47953, 53287, 49055, 54308, 50104, 55275, 51103, 56186, 52053, 57042, 52956, 57840,
+		53816, 58582, 54632, 59269, 55409, 59901, 56146, 60481, 56846, 61011, 57509, 61493, 58137, 61930, 58731, 62325,
+		59292, 62681, 59821, 63000, 60318, 63287, 60783, 63543, 61219, 63771, 61625, 63974, 62003, 64155, 62352, 64316,
+		62676, 64458, 62973, 64584, 63246, 64696, 63496, 64795, 63723, 64883, 63929, 64960, 64116, 65029, 64284, 65090,
+		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 1, 0, 1, 0, 2,
+		0, 3, 0, 4, 1, 6, 1, 9, 1, 12, 2, 16, 4, 21, 6, 28,
+		9, 36, 13, 46, 19, 59, 28, 75, 39, 95, 53, 122, 72, 156, 96, 200,
+		125, 257, 161, 330, 204, 423, 256, 541, 317, 688, 388, 870, 470, 1093, 564, 1363,
+		671, 1684, 793, 2063, 932, 2504, 1088, 3010, 1265, 3586, 1466, 4232, 1695, 4950, 1954, 5737,
+		2250, 6592, 2586, 7512, 2969, 8493, 3405, 9528, 3900, 10612, 4459, 11739, 5089, 12902, 5794, 14096,
+		6581, 15315, 7451, 16554, 8409, 17808, 9455, 19076, 10588, 20354, 11808, 21641, 13111, 22937, 14492, 24241,
+		15945, 25555, 17462, 26879, 19034, 28214, 20653, 29560, 22307, 30919, 23987, 32290, 25681, 33672, 27379, 35064,
+		29071, 36464, 30748, 37869, 32401, 39274, 34024, 40677, 35609, 42071, 37152, 43451, 38648, 44813, 40094, 46150,
+		41489, 47456, 42831, 48727, 44120, 49957, 45357, 51141, 46541, 52276, 47676, 53358, 48761, 54385, 49800, 55355,
+		50793, 56266, 51743, 57118, 52651, 57911, 53519, 58646, 54347, 59324, 55138, 59947, 55891, 60518, 56609, 61038,
+		57292, 61511, 57940, 61939, 58553, 62326, 59133, 62675, 59680, 62988, 60194, 63269, 60676, 63520, 61126, 63745,
+		61546, 63946, 61936, 64125, 62296, 64284, 62629, 64426, 62934, 64553, 63214, 64665, 63470, 64765, 63702, 64854,
+		63912, 64933, 64102, 65004, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 1,
+		0, 1, 0, 1, 0, 2, 0, 3, 0, 5, 1, 6, 1, 9, 2, 12,
+		3, 16, 5, 21, 7, 28, 11, 36, 16, 46, 23, 59, 32, 77, 44, 99,
+		59, 129, 78, 167, 102, 217, 132, 281, 168, 364, 210, 469, 261, 600, 320, 764,
+		389, 965, 468, 1208, 558, 1498, 662, 1841, 781, 2241, 916, 2701, 1071, 3225, 1247, 3813,
+		1450, 4468, 1682, 5187, 1949, 5970, 2255, 6814, 2606, 7715, 3008, 8668, 3467, 9669, 3989, 10712,
+		4579, 11792, 5243, 12904, 5985, 14044, 6810, 15207, 7719, 16391, 8715, 17593, 9797, 18811, 10964, 20045,
+		12212, 21294, 13538, 22560, 14935, 23844, 16397, 25145, 17915, 26465, 19480, 27805, 21082, 29165, 22712, 30545,
+		24359, 31943, 26013, 33358, 27666, 34787, 29308, 36227, 30931, 37673, 32529, 39120, 34094, 40564, 35622, 41997,
+		37110, 43414, 38554, 44808, 39952, 46174, 41303, 47505, 42607, 48795, 43863, 50040, 45074, 51235, 46239, 52377,
+		47359, 53461, 48437, 54487, 49473, 55453, 50469, 56357, 51426, 57200, 52345, 57983, 53227, 58707, 54072, 59374,
+		54881, 59985, 55655, 60544, 56393, 61054, 57097, 61516, 57765, 61935, 58399, 62314, 58998, 62656, 59562, 62963,
+		60093, 63239, 60590, 63487, 61054, 63709, 61485, 63908, 61885, 64086, 62255, 64245, 62595, 64388, 62907, 64515,
+		63192, 64629, 63452, 64731, 63687, 64822, 63900, 64903, 0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 1, 0, 1, 0, 2, 0, 2, 0, 3, 1, 5,
+		1, 7, 2, 9, 2, 12, 4, 16, 6, 21, 9, 28, 13, 36, 18, 47,
+		26, 62, 35, 81, 48, 106, 64, 140, 83, 183, 107, 240, 137, 313, 172, 406,
+		214, 524, 263, 670, 320, 850, 386, 1068, 463, 1329, 551, 1638, 653, 1999, 770, 2415,
+		904, 2890, 1060, 3423, 1240, 4018, 1448, 4672, 1688, 5386, 1967, 6156, 2288, 6980, 2659, 7854,
+		3083, 8775, 3569, 9736, 4120, 10735, 4743, 11768, 5442, 12830, 6221, 13919, 7081, 15032, 8026, 16168,
+		9054, 17326, 10166, 18506, 11357, 19709, 12625, 20935, 13963, 22185, 15366, 23461, 16824, 24764, 18331, 26094,
+		19876, 27452, 21452, 28836, 23047, 30246, 24653, 31680, 26261, 33134, 27862, 34604, 29450, 36085, 31017, 37573,
+		32557, 39061, 34067, 40542, 35541, 42010, 36978, 43457, 38376, 44878, 39733, 46265, 41048, 47612, 42323, 48914,
+		43556, 50166, 44750, 51364, 45904, 52504, 47020, 53584, 48098, 54602, 49140, 55557, 50146, 56449, 51116, 57280,
+		52052, 58049, 52953, 58758, 53818, 59411, 54649, 60009, 55445, 60555, 56205, 61053, 56930, 61505, 57619, 61915,
+		58272, 62286, 58889, 62621, 59470, 62924, 60016, 63196, 60526, 63442, 61001, 63662, 61443, 63861, 61851, 64039,
+		62228, 64199, 62574, 64343, 62890, 64473, 63179, 64589, 63441, 64693, 63678, 64787, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 1, 0, 1, 0, 2,
+		0, 3, 0, 4, 1, 5, 1, 7, 2, 9, 3, 12, 5, 16, 7, 22,
+		10, 29, 15, 38, 21, 50, 29, 66, 39, 88, 51, 117, 67, 155, 87, 205,
+		111, 269, 140, 352, 174, 456, 215, 587, 263, 747, 318, 942, 383, 1177, 458, 1454,
+		545, 1778, 646, 2153, 763, 2580, 900, 3062, 1059, 3599, 1245, 4192, 1463, 4840, 1715, 5540,
+		2009, 6291, 2350, 7089, 2743, 7931, 3194, 8814, 3708, 9735, 4290, 10690, 4946, 11677, 5678, 12693,
+		6490, 13737, 7383, 14808, 8357, 15907, 9411, 17033, 10544, 18188, 11751, 19372, 13027, 20588, 14367, 21837,
+		15763, 23119, 17208, 24436, 18692, 25787, 20208, 27173, 21747, 28590, 23300, 30037, 24858, 31511, 26415, 33006,
+		27962, 34518, 29494, 36041, 31005, 37568, 32491, 39092, 33949, 40605, 35376, 42100, 36769, 43571, 38128, 45009,
+		39453, 46409, 40742, 47764, 41996, 49069, 43216, 50320, 44402, 51512, 45555, 52643, 46674, 53712, 47761, 54716,
+		48816, 55656, 49838, 56532, 50827, 57346, 51784, 58098, 52707, 58792, 53595, 59429, 54449, 60013, 55268, 60547,
+		56050, 61033, 56796, 61475, 57504, 61877, 58175, 62242, 58809, 62572, 59404, 62870, 59962, 63141, 60483, 63385,
+		60967, 63605, 61416, 63805, 61831, 63984, 62212, 64147, 62562, 64294, 62881, 64426, 63172, 64545, 63435, 64653,
+		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 1,
+		0, 1, 0, 1, 0, 2, 0, 3, 1, 4, 1, 5, 2, 7, 2, 10,
+		4, 13, 6, 17, 8, 23, 12, 30, 17, 41, 23, 54, 31, 73, 41, 98,
+		54, 131, 70, 175, 90, 232, 114, 305, 142, 397, 175, 513, 215, 655, 261, 829,
+		316, 1038, 379, 1286, 454, 1576, 541, 1912, 643, 2295, 764, 2729, 905, 3212, 1072, 3747,
+		1267, 4331, 1497, 4965, 1765, 5646, 2078, 6372, 2440, 7141, 2858, 7949, 3336, 8794, 3879, 9674,
+		4492, 10588, 5178, 11533, 5941, 12510, 6782, 13518, 7700, 14558, 8697, 15630, 9769, 16736, 10914, 17879,
+		12126, 19058, 13401, 20277, 14732, 21537, 16111, 22837, 17532, 24179, 18985, 25561, 20464, 26982, 21959, 28438,
+		23464, 29926, 24971, 31441, 26474, 32978, 27967, 34530, 29445, 36090, 30904, 37650, 32341, 39204, 33754, 40742,
+		35140, 42257, 36498, 43741, 37828, 45189, 39129, 46592, 40402, 47946, 41646, 49245, 42862, 50486, 44050, 51666,
+		45209, 52782, 46340, 53833, 47442, 54819, 48515, 55740, 49558, 56597, 50570, 57391, 51550, 58126, 52497, 58802,
+		53410, 59424, 54287, 59994, 55128, 60515, 55932, 60991, 56696, 61425, 57422, 61820, 58109, 62180, 58755, 62507,
+		59363, 62803, 59930, 63073, 60459, 63318, 60950, 63540, 61404, 63741, 61823, 63924, 62207, 64090, 62558, 64240,
+		62878, 64376, 63169, 64500, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 1, 0, 1, 0, 1, 0, 2, 0, 3, 1, 4,
+		1, 5, 2, 7, 3, 10, 4, 13, 7, 18, 9, 24, 13, 33, 18, 45,
+		25, 61, 33, 82, 44, 111, 57, 149, 73, 199, 92, 263, 115, 345, 142, 447,
+		175, 574, 214, 728, 260, 913, 314, 1134, 377, 1392, 453, 1692, 542, 2034, 648, 2422,
+		774, 2855, 923, 3335, 1099, 3861, 1307, 4432, 1552, 5047, 1838, 5705, 2171, 6403, 2557, 7140,
+		3000, 7913, 3505, 8723, 4076, 9566, 4717, 10444, 5431, 11355, 6220, 12301, 7083, 13282, 8021, 14301,
+		9032, 15359, 10113, 16459, 11260, 17601, 12468, 18789, 13731, 20023, 15043, 21304, 16397, 22632, 17785, 24007,
+		19200, 25426, 20636, 26886, 22084, 28383, 23538, 29913, 24993, 31468, 26443, 33044, 27885, 34631, 29313, 36222,
+		30726, 37809, 32121, 39383, 33496, 40937, 34850, 42462, 36183, 43952, 37494, 45398, 38783, 46796, 40049, 48141,
+		41292, 49427, 42513, 50651, 43710, 51812, 44884, 52907, 46032, 53937, 47155, 54901, 48251, 55800, 49318, 56635,
+		50354, 57410, 51359, 58125, 52331, 58785, 53267, 59392, 54167, 59949, 55028, 60460, 55850, 60927, 56631, 61355,
+		57372, 61745, 58071, 62102, 58728, 62427, 59343, 62724, 59918, 62995, 60452, 63242, 60947, 63467, 61403, 63672,
+		61823, 63859, 62207, 64029, 62558, 64184, 62877, 64325, 0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 1, 0, 1, 0, 1,
+		0, 2, 1, 3, 1, 4, 2, 6, 2, 8, 4, 10, 5, 14, 7, 20,
+		11, 27, 15, 37, 20, 51, 26, 69, 35, 94, 45, 128, 58, 171, 74, 227,
+		93, 299, 115, 389, 143, 501, 175, 637, 213, 801, 259, 996, 314, 1225, 379, 1491,
+		457, 1796, 550, 2141, 661, 2528, 794, 2957, 953, 3428, 1141, 3940, 1364, 4494, 1626, 5087,
+		1932, 5719, 2287, 6389, 2697, 7094, 3164, 7836, 3695, 8612, 4292, 9425, 4958, 10273, 5694, 11159,
+		6502, 12084, 7381, 13051, 8331, 14060, 9347, 15116, 10428, 16221, 11568, 17375, 12763, 18581, 14006, 19840,
+		15291, 21152, 16611, 22515, 17961, 23927, 19334, 25386, 20722, 26887, 22121, 28425, 23525, 29994, 24930, 31586,
+		26331, 33194, 27726, 34809, 29112, 36423, 30486, 38028, 31847, 39614, 33194, 41174, 34527, 42700, 35845, 44185,
+		37146, 45622, 38432, 47006, 39701, 48332, 40952, 49598, 42186, 50800, 43400, 51937, 44593, 53007, 45764, 54012,
+		46911, 54952, 48032, 55827, 49124, 56641, 50186, 57396, 51216, 58094, 52211, 58738, 53168, 59332, 54088, 59878,
+		54967, 60381, 55804, 60842, 56599, 61265, 57350, 61653, 58058, 62009, 58722, 62335, 59343, 62634, 59921, 62908,
+		60457, 63159, 60953, 63389, 61409, 63599, 61828, 63791, 62211, 63967, 62560, 64127, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 1,
+		0, 1, 0, 1, 0, 2, 0, 2, 1, 3, 1, 4, 2, 6, 3, 8,
+		4, 11, 6, 16, 8, 22, 12, 30, 16, 42, 21, 58, 28, 80, 36, 109,
+		47, 147, 59, 196, 75, 259, 93, 338, 116, 436, 143, 555, 175, 700, 214, 872,
+		261, 1074, 317, 1310, 385, 1579, 467, 1885, 566, 2229, 685, 2610, 827, 3031, 998, 3489,
+		1200, 3985, 1439, 4519, 1719, 5089, 2045, 5695, 2422, 6337, 2854, 7014, 3345, 7727, 3899, 8478,
+		4517, 9266, 5203, 10094, 5956, 10964, 6777, 11879, 7665, 12841, 8617, 13854, 9631, 14919, 10703, 16038,
+		11828, 17215, 13001, 18448, 14216, 19739, 15468, 21087, 16751, 22489, 18058, 23941, 19385, 25441, 20727, 26981,
+		22078, 28557, 23435, 30159, 24793, 31781, 26152, 33414, 27507, 35049, 28858, 36677, 30202, 38290, 31540, 39878,
+		32869, 41434, 34191, 42951, 35503, 44422, 36805, 45841, 38096, 47203, 39376, 48506, 40643, 49745, 41895, 50920,
+		43131, 52030, 44349, 53073, 45545, 54052, 46718, 54966, 47865, 55819, 48983, 56612, 50069, 57348, 51121, 58030,
+		52137, 58661, 53113, 59244, 54049, 59782, 54942, 60279, 55791, 60736, 56595, 61158, 57354, 61547, 58067, 61904,
+		58734, 62234, 59356, 62537, 59935, 62816, 60470, 63072, 60964, 63308, 61418, 63524, 61834, 63723, 62214, 63905,
+		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 1, 0, 1, 0, 1, 0, 2, 1, 2, 1, 3,
+		1, 5, 2, 6, 3, 9, 5, 13, 7, 18, 9, 25, 12, 35, 17, 49,
+		22, 68, 29, 93, 37, 126, 47, 169, 60, 223, 75, 292, 94, 378, 116, 483,
+		143, 609, 176, 760, 216, 938, 265, 1145, 325, 1383, 397, 1653, 484, 1957, 591, 2295,
+		719, 2669, 872, 3077, 1056, 3520, 1273, 3998, 1529, 4510, 1828, 5058, 2174, 5640, 2571, 6257,
+		3024, 6911, 3535, 7602, 4108, 8333, 4743, 9106, 5442, 9923, 6206, 10788, 7033, 11703, 7922, 12671,
+		8869, 13697, 9873, 14780, 10928, 15925, 12031, 17130, 13176, 18398, 14358, 19726, 15573, 21112, 16815, 22554,
+		18079, 24046, 19361, 25583, 20657, 27159, 21964, 28766, 23278, 30395, 24598, 32039, 25921, 33688, 27245, 35333,
+		28570, 36965, 29895, 38575, 31219, 40155, 32541, 41698, 33861, 43197, 35177, 44645, 36488, 46039, 37793, 47374,
+		39091, 48648, 40379, 49857, 41655, 51002, 42916, 52082, 44159, 53098, 45382, 54050, 46581, 54940, 47753, 55772,
+		48895, 56546, 50003, 57266, 51075, 57935, 52107, 58555, 53098, 59131, 54046, 59664, 54949, 60158, 55805, 60615,
+		56614, 61038, 57376, 61429, 58090, 61791, 58758, 62126, 59378, 62435, 59954, 62720, 60486, 62984, 60976, 63226,
+		61426, 63450, 61838, 63656, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 1, 0, 1, 0, 1,
+		0, 2, 1, 3, 1, 4, 2, 5, 3, 7, 4, 10, 5, 15, 7, 21,
+		10, 30, 13, 41, 17, 57, 23, 79, 29, 108, 38, 145, 48, 192, 60, 252,
+		76, 326, 94, 418, 117, 529, 145, 661, 180, 816, 222, 998, 274, 1207, 337, 1444,
+		415, 1712, 510, 2010, 624, 2340, 763, 2702, 929, 3097, 1126, 3524, 1359, 3983, 1632, 4476,
+		1948, 5002, 2313, 5563, 2729, 6161, 3199, 6797, 3727, 7474, 4314, 8193, 4961, 8959, 5668, 9775,
+		6435, 10644, 7261, 11569, 8143, 12554, 9079, 13601, 10066, 14711, 11098, 15887, 12173, 17127, 13286, 18432,
+		14432, 19799, 15608, 21224, 16808, 22704, 18030, 24232, 19270, 25802, 20526, 27407, 21794, 29037, 23073, 30685,
+		24361, 32341, 25657, 33995, 26960, 35640, 28270, 37265, 29585, 38863, 30905, 40426, 32229, 41947, 33556, 43420,
+		34884, 44840, 36212, 46204, 37537, 47507, 38857, 48748, 40170, 49925, 41472, 51039, 42760, 52089, 44030, 53077,
+		45279, 54005, 46502, 54873, 47697, 55685, 48859, 56444, 49985, 57151, 51072, 57810, 52117, 58424, 53119, 58995,
+		54074, 59527, 54981, 60021, 55841, 60481, 56651, 60908, 57411, 61304, 58123, 61673, 58787, 62015, 59403, 62331,
+		59974, 62625, 60501, 62896, 60985, 63147, 61430, 63378, 0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+		0, 1, 0, 1, 0, 1, 1, 2, 1, 3, 1, 4, 2, 6, 3, 8,
+		4, 12, 6, 17, 8, 25, 10, 35, 14, 49, 18, 67, 23, 92, 30, 124,
+		38, 165, 49, 217, 61, 281, 77, 361, 96, 457, 120, 572, 149, 707, 186, 866,
+		231, 1048, 287, 1256, 356, 1491, 440, 1753, 543, 2044, 667, 2364, 817, 2714, 995, 3094,
+		1207, 3505, 1455, 3947, 1744, 4422, 2077, 4931, 2458, 5477, 2889, 6060, 3374, 6686, 3913, 7355,
+		4509, 8072, 5162, 8841, 5871, 9664, 6635, 10547, 7453, 11491, 8323, 12499, 9241, 13574, 10205, 14717,
+		11210, 15928, 12254, 17206, 13332, 18548, 14442, 19953, 15578, 21415, 16740, 22928, 17923, 24487, 19126, 26082,
+		20346, 27707, 21583, 29352, 22835, 31008, 24101, 32666, 25380, 34316, 26672, 35950, 27976, 37559, 29291, 39136,
+		30616, 40673, 31951, 42165, 33292, 43607, 34639, 44993, 35989, 46322, 37338, 47591, 38684, 48798, 40024, 49942,
+		41353, 51026, 42667, 52048, 43962, 53011, 45234, 53916, 46479, 54766, 47692, 55562, 48870, 56308, 50009, 57007,
+		51107, 57660, 52160, 58271, 53166, 58842, 54124, 59376, 55032, 59874, 55890, 60339, 56696, 60772, 57452, 61177,
+		58159, 61553, 58816, 61904, 59426, 62230, 59989, 62532, 60509, 62813, 60987, 63072, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 1, 0, 1, 0, 1, 1, 2, 1, 3,
+		2, 5, 2, 7, 3, 10, 4, 14, 6, 21, 8, 29, 11, 41, 14, 57,
+		18, 78, 24, 106, 31, 141, 39, 186, 49, 241, 62, 310, 79, 393, 99, 493,
+		124, 611, 156, 748, 195, 907, 245, 1088, 305, 1293, 380, 1523, 472, 1779, 584, 2061,
+		718, 2370, 879, 2707, 1071, 3073, 1296, 3469, 1558, 3897, 1861, 4358, 2208, 4855, 2602, 5390,
+		3046, 5967, 3540, 6588, 4087, 7259, 4687, 7982, 5339, 8761, 6044, 9601, 6800, 10504, 7605, 11473,
+		8457, 12511, 9353, 13619, 10290, 14798, 11266, 16045, 12277, 17360, 13321, 18739, 14394, 20178, 15495, 21672,
+		16621, 23212, 17771, 24793, 18943, 26406, 20137, 28041, 21350, 29690, 22584, 31344, 23837, 32994, 25109, 34630,
+		26399, 36244, 27706, 37829, 29030, 39377, 30369, 40882, 31720, 42339, 33082, 43745, 34453, 45095, 35828, 46387,
+		37204, 47620, 38577, 48794, 39943, 49907, 41297, 50962, 42636, 51959, 43953, 52900, 45244, 53787, 46506, 54622,
+		47733, 55407, 48922, 56146, 50069, 56839, 51172, 57491, 52227, 58103, 53233, 58677, 54189, 59216, 55092, 59721,
+		55944, 60194, 56744, 60636, 57492, 61050, 58190, 61437, 58839, 61797, 59440, 62133, 59996, 62445, 60507, 62735,
+		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 1, 0, 1, 0, 1,
+		1, 2, 1, 3, 1, 4, 2, 6, 2, 8, 3, 12, 5, 17, 6, 25,
+		8, 35, 11, 48, 15, 66, 19, 90, 24, 120, 31, 158, 40, 206, 51, 265,
+		64, 337, 82, 423, 104, 525, 131, 644, 165, 781, 209, 939, 262, 1117, 329, 1318,
+		410, 1542, 510, 1789, 631, 2061, 776, 2360, 949, 2685, 1152, 3039, 1389, 3423, 1664, 3840,
+		1980, 4292, 2338, 4782, 2742, 5314, 3193, 5890, 3693, 6516, 4242, 7195, 4841, 7931, 5488, 8729,
+		6184, 9591, 6926, 10521, 7714, 11521, 8544, 12592, 9415, 13735, 10324, 14949, 11269, 16233, 12248, 17582,
+		13259, 18994, 14300, 20461, 15369, 21979, 16466, 23539, 17589, 25133, 18739, 26752, 19913, 28389, 21113, 30032,
+		22338, 31674, 23588, 33306, 24862, 34919, 26158, 36505, 27477, 38058, 28817, 39571, 30175, 41039, 31549, 42459,
+		32936, 43826, 34332, 45138, 35734, 46394, 37137, 47593, 38536, 48735, 39927, 49820, 41304, 50850, 42662, 51825,
+		43997, 52749, 45303, 53622, 46575, 54447, 47811, 55226, 49005, 55961, 50154, 56655, 51256, 57309, 52309, 57926,
+		53310, 58507, 54259, 59053, 55154, 59567, 55997, 60051, 56787, 60504, 57525, 60929, 58212, 61326, 58851, 61698,
+		59442, 62044, 59989, 62366, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+		0, 1, 0, 1, 0, 1, 1, 2, 1, 3, 1, 5, 2, 7, 3, 10,
+		4, 14, 5, 21, 7, 29, 9, 41, 11, 56, 15, 76, 19, 102, 25, 135,
+		32, 176, 41, 226, 53, 288, 68, 362, 86, 449, 110, 552, 140, 671, 178, 807,
+		225, 962, 284, 1136, 357, 1331, 446, 1548, 554, 1787, 684, 2050, 839, 2339, 1022, 2654,
+		1236, 2999, 1485, 3375, 1770, 3786, 2095, 4234, 2462, 4723, 2872, 5257, 3327, 5841, 3828, 6477,
+		4374, 7172, 4967, 7928, 5605, 8749, 6287, 9639, 7012, 10599, 7779, 11632, 8586, 12738, 9430, 13916,
+		10311, 15164, 11227, 16480, 12177, 17859, 13158, 19296, 14171, 20785, 15215, 22319, 16290, 23889, 17394, 25486,
+		18529, 27103, 19694, 28730, 20890, 30358, 22115, 31979, 23370, 33584, 24653, 35166, 25964, 36718, 27301, 38234,
+		28662, 39708, 30044, 41137, 31443, 42517, 32857, 43845, 34280, 45121, 35708, 46342, 37136, 47510, 38558, 48623,
+		39970, 49684, 41365, 50694, 42738, 51653, 44085, 52564, 45400, 53429, 46678, 54249, 47916, 55026, 49109, 55763,
+		50255, 56461, 51351, 57121, 52396, 57746, 53388, 58336, 54326, 58894, 55210, 59419, 56040, 59914, 56818, 60380,
+		57544, 60816, 58220, 61225, 58848, 61608, 59429, 61964, 0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 1, 0, 1, 0, 2, 1, 2, 1, 4,
+		1, 6, 2, 8, 3, 12, 4, 17, 5, 24, 7, 34, 9, 47, 12, 64,
+		15, 86, 20, 114, 26, 149, 34, 192, 43, 245, 56, 308, 72, 383, 93, 471,
+		119, 573, 152, 691, 194, 824, 246, 976, 310, 1145, 389, 1334, 486, 1544, 602, 1776,
+		741, 2031, 905, 2312, 1098, 2621, 1321, 2960, 1579, 3332, 1872, 3741, 2204, 4191, 2575, 4685,
+		2988, 5228, 3442, 5824, 3940, 6478, 4480, 7193, 5063, 7974, 5688, 8823, 6354, 9743, 7059, 10736,
+		7804, 11803, 8586, 12942, 9406, 14152, 10260, 15431, 11150, 16774, 12074, 18176, 13031, 19631, 14023, 21133,
+		15049, 22673, 16108, 24243, 17203, 25834, 18332, 27439, 19496, 29047, 20695, 30651, 21928, 32243, 23196, 33815,
+		24496, 35360, 25827, 36873, 27187, 38348, 28572, 39782, 29980, 41170, 31406, 42511, 32846, 43803, 34294, 45044,
+		35746, 46235, 37196, 47375, 38637, 48465, 40065, 49507, 41473, 50501, 42855, 51450, 44208, 52354, 45525, 53215,
+		46802, 54035, 48036, 54816, 49223, 55559, 50360, 56264, 51446, 56934, 52479, 57570, 53457, 58172, 54382, 58742,
+		55252, 59281, 56068, 59789, 56832, 60267, 57545, 60716, 58209, 61136, 58826, 61529, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 1, 0, 1, 0, 1,
+		1, 2, 1, 3, 1, 5, 2, 7, 2, 10, 3, 14, 4, 20, 5, 29,
+		7, 40, 9, 54, 12, 73, 16, 96, 21, 126, 27, 163, 36, 207, 46, 261,
+		60, 325, 78, 400, 101, 488, 130, 589, 166, 704, 212, 835, 269, 982, 340, 1146,
+		425, 1329, 529, 1533, 653, 1759, 800, 2008, 972, 2285, 1173, 2590, 1404, 2927, 1668, 3300,
+		1966, 3713, 2301, 4170, 2674, 4675, 3086, 5232, 3537, 5846, 4027, 6522, 4558, 7262, 5128, 8071,
+		5738, 8950, 6385, 9902, 7071, 10927, 7794, 12025, 8553, 13194, 9349, 14432, 10180, 15735, 11048, 17098,
+		11951, 18515, 12892, 19980, 13869, 21485, 14884, 23022, 15937, 24583, 17029, 26159, 18161, 27742, 19331, 29323,
+		20542, 30896, 21790, 32453, 23076, 33986, 24398, 35491, 25753, 36963, 27138, 38397, 28550, 39790, 29984, 41139,
+		31436, 42443, 32900, 43701, 34371, 44912, 35842, 46077, 37308, 47195, 38763, 48268, 40201, 49297, 41615, 50282,
+		43001, 51225, 44352, 52128, 45666, 52991, 46936, 53816, 48161, 54604, 49336, 55356, 50460, 56073, 51531, 56756,
+		52548, 57404, 53511, 58021, 54419, 58604, 55273, 59156, 56075, 59678, 56825, 60168, 57525, 60629, 58177, 61061,
+		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+		0, 1, 0, 1, 0, 2, 1, 2, 1, 4, 1, 6, 2, 8, 2, 12,
+		3, 17, 4, 24, 5, 33, 7, 45, 10, 61, 13, 81, 17, 106, 22, 137,
+		29, 175, 38, 221, 50, 275, 66, 339, 86, 414, 111, 500, 143, 599, 183, 711,
+		234, 838, 296, 981, 372, 1141, 464, 1320, 574, 1519, 705, 1741, 859, 1987, 1038, 2262,
+		1245, 2567, 1481, 2907, 1749, 3286, 2050, 3707, 2385, 4176, 2756, 4696, 3164, 5272, 3608, 5909,
+		4090, 6609, 4608, 7377, 5164, 8215, 5757, 9125, 6386, 10107, 7052, 11162, 7754, 12288, 8493, 13482,
+		9269, 14742, 10082, 16062, 10933, 17437, 11823, 18861, 12753, 20326, 13723, 21825, 14735, 23349, 15790, 24892,
+		16887, 26444, 18028, 27998, 19213, 29546, 20440, 31082, 21709, 32599, 23018, 34092, 24365, 35555, 25745, 36985,
+		27157, 38378, 28594, 39733, 30053, 41046, 31527, 42318, 33012, 43547, 34500, 44734, 35986, 45878, 37463, 46981,
+		38925, 48042, 40366, 49063, 41780, 50045, 43162, 50989, 44506, 51896, 45810, 52766, 47068, 53600, 48278, 54399,
+		49438, 55163, 50545, 55894, 51598, 56591, 52597, 57254, 53542, 57885, 54433, 58483, 55271, 59049, 56057, 59583,
+		56793, 60086, 57481, 60557, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 1, 0, 1, 0, 1, 0, 2, 1, 3, 1, 5,
+		1, 7, 2, 10, 2, 14, 3, 20, 4, 28, 6, 38, 8, 51, 10, 68,
+		14, 89, 18, 115, 24, 147, 32, 186, 42, 232, 55, 286, 73, 350, 95, 423,
+		123, 508, 158, 604, 202, 714, 257, 838, 324, 977, 406, 1133, 503, 1309, 619, 1505,
+		756, 1725, 916, 1972, 1100, 2248, 1311, 2558, 1550, 2905, 1820, 3293, 2121, 3727, 2454, 4212,
+		2821, 4752, 3222, 5350, 3657, 6011, 4128, 6739, 4633, 7535, 5174, 8402, 5750, 9340, 6362, 10350,
+		7010, 11430, 7695, 12579, 8417, 13792, 9178, 15066, 9978, 16395, 10819, 17774, 11702, 19195, 12628, 20651,
+		13598, 22135, 14615, 23640, 15678, 25156, 16787, 26678, 17944, 28197, 19148, 29707, 20397, 31203, 21690, 32678,
+		23024, 34129, 24396, 35551, 25802, 36941, 27238, 38297, 28699, 39616, 30179, 40899, 31672, 42143, 33172, 43350,
+		34672, 44518, 36165, 45649, 37647, 46742, 39109, 47798, 40546, 48818, 41954, 49803, 43326, 50752, 44658, 51667,
+		45946, 52548, 47187, 53394, 48379, 54207, 49519, 54987, 50606, 55733, 51640, 56445, 52619, 57124, 53546, 57769,
+		54419, 58381, 55241, 58960, 56013, 59506, 56737, 60020, 0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 1, 0, 1, 0, 2,
+		0, 3, 1, 4, 1, 6, 1, 8, 2, 12, 2, 17, 3, 23, 5, 31,
+		6, 42, 8, 57, 11, 74, 15, 96, 20, 123, 27, 156, 35, 194, 47, 240,
+		62, 294, 81, 357, 105, 429, 136, 512, 175, 606, 223, 713, 282, 834, 354, 971,
+		440, 1125, 543, 1299, 664, 1495, 805, 1716, 969, 1966, 1157, 2248, 1370, 2565, 1610, 2923,
+		1879, 3325, 2177, 3776, 2507, 4280, 2867, 4841, 3260, 5464, 3685, 6151, 4143, 6905, 4635, 7729,
+		5161, 8622, 5723, 9586, 6319, 10619, 6953, 11719, 7624, 12884, 8335, 14109, 9086, 15389, 9879, 16719,
+		10716, 18093, 11598, 19503, 12528, 20942, 13505, 22404, 14533, 23880, 15610, 25365, 16737, 26851, 17915, 28331,
+		19141, 29801, 20414, 31255, 21732, 32689, 23092, 34099, 24489, 35482, 25919, 36836, 27376, 38159, 28856, 39449,
+		30352, 40706, 31858, 41930, 33367, 43120, 34872, 44277, 36367, 45400, 37846, 46490, 39302, 47548, 40729, 48572,
+		42124, 49564, 43480, 50524, 44794, 51451, 46063, 52346, 47283, 53208, 48453, 54037, 49572, 54832, 50638, 55594,
+		51651, 56322, 52611, 57016, 53519, 57675, 54376, 58300, 55183, 58891, 55942, 59448, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 1,
+		0, 1, 0, 1, 0, 2, 1, 3, 1, 5, 1, 7, 1, 10, 2, 14,
+		3, 19, 4, 26, 5, 35, 7, 47, 9, 62, 12, 80, 16, 103, 22, 130,
+		30, 162, 39, 201, 52, 247, 69, 300, 90, 361, 117, 432, 151, 513, 193, 605,
+		245, 710, 308, 829, 384, 965, 474, 1119, 581, 1294, 706, 1492, 851, 1718, 1017, 1974,
+		1206, 2264, 1420, 2593, 1659, 2964, 1925, 3383, 2220, 3853, 2543, 4379, 2896, 4963, 3279, 5611,
+		3693, 6323, 4140, 7103, 4620, 7951, 5133, 8868, 5682, 9852, 6266, 10903, 6889, 12016, 7552, 13190,
+		8255, 14419, 9003, 15697, 9795, 17020, 10634, 18380, 11523, 19771, 12462, 21186, 13453, 22619, 14496, 24063,
+		15593, 25511, 16741, 26958, 17942, 28398, 19193, 29827, 20491, 31241, 21834, 32635, 23217, 34008, 24636, 35356,
+		26086, 36679, 27561, 37974, 29055, 39241, 30561, 40480, 32073, 41690, 33584, 42871, 35088, 44023, 36577, 45145,
+		38046, 46239, 39489, 47302, 40901, 48336, 42277, 49340, 43613, 50314, 44906, 51256, 46152, 52167, 47349, 53046,
+		48496, 53891, 49592, 54703, 50636, 55481, 51628, 56224, 52570, 56931, 53461, 57603, 54303, 58240, 55098, 58841,
+		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 1, 0, 1, 0, 2, 0, 3, 1, 4, 1, 6,
+		1, 8, 1, 11, 2, 16, 3, 22, 4, 29, 5, 39, 7, 51, 10, 66,
+		14, 85, 18, 108, 25, 135, 33, 168, 44, 206, 59, 251, 77, 303, 101, 363,
+		130, 433, 167, 512, 212, 603, 267, 707, 334, 826, 413, 962, 507, 1118, 617, 1296,
+		745, 1499, 891, 1732, 1058, 1997, 1248, 2299, 1460, 2642, 1697, 3030, 1959, 3467, 2248, 3958,
+		2564, 4505, 2909, 5113, 3283, 5785, 3687, 6521, 4123, 7323, 4592, 8192, 5095, 9127, 5634, 10126,
+		6211, 11188, 6828, 12308, 7486, 13483, 8189, 14708, 8938, 15977, 9735, 17284, 10583, 18624, 11483, 19990,
+		12437, 21376, 13446, 22775, 14509, 24182, 15628, 25592, 16800, 26999, 18025, 28399, 19300, 29789, 20623, 31164,
+		21988, 32523, 23392, 33863, 24829, 35183, 26294, 36480, 27780, 37755, 29281, 39007, 30791, 40234, 32303, 41437,
+		33809, 42615, 35304, 43769, 36781, 44896, 38235, 45998, 39660, 47073, 41051, 48120, 42405, 49139, 43717, 50129,
+		44985, 51089, 46206, 52017, 47378, 52912, 48502, 53774, 49575, 54602, 50598, 55395, 51572, 56151, 52496, 56871,
+		53373, 57554, 54202, 58200, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0, 0, 1, 0, 1, 0, 1, 0, 2,
+		0, 3, 1, 5, 1, 6, 1, 9, 2, 13, 2, 18, 3, 24, 4, 32,
+		6, 42, 8, 55, 11, 70, 15, 89, 21, 112, 28, 140, 38, 172, 50, 210,
+		66, 254, 86, 305, 112, 364, 144, 433, 183, 511, 231, 602, 290, 707, 359, 827,
+		441, 965, 537, 1125, 649, 1308, 778, 1519, 926, 1761, 1093, 2037, 1280, 2353, 1490, 2712,
+		1723, 3118, 1981, 3575, 2263, 4087, 2572, 4656, 2909, 5286, 3274, 5979, 3670, 6736, 4097, 7557,
+		4558, 8442, 5054, 9389, 5588, 10397, 6161, 11463, 6777, 12582, 7437, 13751, 8144, 14965, 8900, 16217,
+		9708, 17503, 10569, 18817, 11485, 20153, 12458, 21505, 13487, 22868, 14573, 24238, 15715, 25608, 16912, 26977,
+		18160, 28339, 19458, 29693, 20801, 31035, 22185, 32363, 23605, 33677, 25055, 34974, 26528, 36254, 28020, 37515,
+		29522, 38758, 31029, 39981, 32533, 41184, 34028, 42366, 35508, 43527, 36967, 44665, 38400, 45779, 39803, 46869,
+		41169, 47932, 42497, 48969, 43783, 49976, 45025, 50953, 46220, 51898, 47369, 52810, 48469, 53688, 49521, 54530,
+		50525, 55336, 51482, 56103, 52392, 56833, 53256, 57525, 0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 1,
+		0, 1, 0, 2, 0, 2, 0, 4, 1, 5, 1, 8, 1, 11, 2, 15,
+		2, 20, 3, 26, 5, 35, 7, 45, 9, 58, 13, 74, 17, 93, 24, 116,
+		32, 143, 43, 174, 56, 212, 74, 255, 96, 306, 124, 365, 158, 433, 200, 512,
+		250, 603, 311, 710, 382, 833, 467, 975, 564, 1141, 677, 1332, 807, 1552, 954, 1805,
+		1119, 2095, 1305, 2426, 1511, 2802, 1740, 3227, 1991, 3704, 2268, 4236, 2570, 4826, 2900, 5476,
+		3258, 6187, 3647, 6960, 4068, 7794, 4524, 8689, 5017, 9644, 5550, 10654, 6124, 11717, 6743, 12828,
+		7409, 13985, 8125, 15181, 8894, 16411, 9716, 17670, 10595, 18954, 11530, 20256, 12524, 21573, 13576, 22899,
+		14685, 24231, 15851, 25565, 17070, 26898, 18340, 28227, 19657, 29549, 21017, 30863, 22415, 32168, 23845, 33462,
+		25301, 34744, 26777, 36013, 28267, 37268, 29763, 38509, 31260, 39734, 32750, 40943, 34228, 42135, 35688, 43307,
+		37124, 44459, 38533, 45590, 39909, 46697, 41248, 47778, 42549, 48832, 43808, 49858, 45023, 50852, 46194, 51813,
+		47319, 52740, 48398, 53632, 49432, 54486, 50419, 55302, 51362, 56078, 52261, 56815, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 1, 0, 1, 0, 1, 0, 2, 0, 3, 0, 4, 1, 6,
+		1, 9, 1, 12, 2, 16, 3, 22, 4, 28, 6, 37, 8, 48, 11, 61,
+		15, 76, 20, 95, 27, 118, 36, 145, 48, 176, 63, 213, 82, 256, 106, 307,
+		136, 366, 172, 434, 216, 515, 268, 608, 330, 718, 404, 846, 489, 994, 588, 1167,
+		701, 1367, 829, 1599, 975, 1865, 1138, 2170, 1321, 2517, 1523, 2911, 1747, 3354, 1994, 3849,
+		2265, 4400, 2562, 5007, 2886, 5673, 3239, 6399, 3624, 7183, 4042, 8026, 4497, 8926, 4991, 9880,
+		5526, 10886, 6106, 11940, 6733, 13038, 7410, 14176, 8139, 15349, 8923, 16552, 9763, 17782, 10662, 19033,
+		11619, 20301, 12635, 21582, 13710, 22873, 14841, 24170, 16028, 25470, 17267, 26772, 18554, 28072, 19886, 29370,
+		21258, 30663, 22664, 31951, 24098, 33232, 25554, 34506, 27026, 35772, 28507, 37028, 29991, 38273, 31472, 39507,
+		32942, 40727, 34398, 41931, 35834, 43119, 37244, 44289, 38624, 45437, 39972, 46562, 41284, 47662, 42557, 48734,
+		43789, 49776, 44980, 50785, 46127, 51761, 47232, 52701, 48292, 53603, 49310, 54467, 50285, 55290, 51217, 56072,
+		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0, 0, 1, 0, 1, 0, 2, 0, 2,
+		0, 3, 1, 5, 1, 7, 1, 10, 2, 13, 2, 18, 3, 23, 5, 30,
+		6, 39, 9, 50, 12, 63, 17, 78, 23, 97, 31, 120, 41, 146, 54, 177,
+		70, 214, 91, 258, 116, 308, 147, 368, 185, 439, 231, 521, 284, 619, 348, 733,
+		422, 866, 508, 1023, 607, 1204, 719, 1416, 847, 1659, 990, 1940, 1151, 2260, 1330, 2623,
+		1529, 3033, 1748, 3493, 1991, 4005, 2257, 4572, 2550, 5194, 2871, 5872, 3221, 6607, 3605, 7398,
+		4024, 8243, 4481, 9142, 4980, 10090, 5522, 11086, 6111, 12125, 6750, 13204, 7441, 14319, 8187, 15466,
+		8989, 16640, 9850, 17838, 10769, 19056, 11748, 20291, 12786, 21538, 13882, 22797, 15034, 24062, 16238, 25334,
+		17493, 26610, 18793, 27888, 20135, 29168, 21512, 30447, 22920, 31726, 24351, 33002, 25801, 34275, 27262, 35544,
+		28728, 36807, 30194, 38062, 31653, 39308, 33100, 40543, 34530, 41764, 35938, 42970, 37319, 44158, 38671, 45324,
+		39990, 46468, 41274, 47585, 42520, 48673, 43728, 49729, 44896, 50752, 46023, 51740, 47110, 52689, 48156, 53599,
+		49161, 54468, 50127, 55296, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 1, 0, 1,
+		0, 1, 0, 2, 0, 3, 0, 4, 1, 6, 1, 8, 1, 11, 2, 14,
+		3, 19, 4, 25, 5, 32, 7, 41, 10, 51, 14, 64, 19, 80, 26, 99,
+		35, 121, 46, 147, 60, 179, 77, 216, 99, 260, 126, 312, 158, 374, 198, 447,
+		244, 533, 299, 635, 363, 755, 438, 896, 523, 1061, 621, 1253, 733, 1476, 858, 1732,
+		1000, 2027, 1158, 2362, 1334, 2741, 1529, 3166, 1746, 3641, 1985, 4167, 2248, 4746, 2539, 5378,
+		2859, 6064, 3210, 6804, 3596, 7595, 4019, 8438, 4482, 9330, 4988, 10267, 5541, 11248, 6143, 12268,
+		6797, 13324, 7505, 14414, 8269, 15532, 9091, 16676, 9973, 17843, 10913, 19029, 11913, 20232, 12971, 21450,
+		14085, 22680, 15253, 23920, 16472, 25169, 17737, 26426, 19045, 27689, 20390, 28958, 21766, 30231, 23169, 31507,
+		24592, 32785, 26028, 34063, 27473, 35341, 28920, 36615, 30363, 37885, 31797, 39147, 33216, 40399, 34618, 41639,
+		35996, 42863, 37348, 44069, 38671, 45254, 39963, 46414, 41220, 47546, 42442, 48648, 43628, 49716, 44776, 50749,
+		45887, 51745, 46960, 52700, 47995, 53614, 48993, 54486, 0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 1, 0, 1, 0, 2, 0, 2, 0, 3, 0, 5, 1, 6,
+		1, 9, 2, 11, 2, 15, 3, 20, 4, 26, 6, 33, 9, 42, 12, 53,
+		16, 66, 22, 81, 29, 100, 39, 122, 51, 149, 66, 181, 84, 219, 107, 264,
+		135, 318, 169, 383, 209, 459, 256, 550, 311, 658, 376, 785, 450, 934, 535, 1109,
+		632, 1312, 742, 1546, 866, 1816, 1005, 2124, 1161, 2473, 1334, 2865, 1527, 3304, 1741, 3791,
+		1979, 4328, 2242, 4915, 2533, 5553, 2854, 6243, 3209, 6982, 3600, 7770, 4030, 8605, 4502, 9485,
+		5020, 10407, 5586, 11368, 6203, 12367, 6874, 13398, 7600, 14460, 8384, 15550, 9227, 16665, 10128, 17802,
+		11088, 18960, 12106, 20135, 13181, 21327, 14310, 22534, 15490, 23755, 16717, 24989, 17987, 26233, 19296, 27489,
+		20639, 28754, 22008, 30027, 23400, 31307, 24808, 32593, 26227, 33882, 27650, 35172, 29073, 36462, 30489, 37749,
+		31895, 39029, 33286, 40300, 34658, 41558, 36007, 42801, 37331, 44024, 38626, 45224, 39892, 46398, 41126, 47542,
+		42327, 48655, 43494, 49732, 44627, 50771, 45725, 51771, 46789, 52729, 47818, 53644, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 1, 0, 1, 0, 1, 0, 2, 0, 3,
+		0, 4, 1, 5, 1, 7, 1, 9, 2, 12, 3, 16, 4, 21, 5, 27,
+		7, 34, 10, 43, 14, 54, 18, 67, 25, 82, 33, 101, 43, 124, 56, 151,
+		72, 184, 91, 224, 115, 271, 144, 328, 178, 395, 219, 476, 266, 573, 322, 687,
+		386, 822, 460, 980, 544, 1165, 640, 1379, 748, 1625, 870, 1907, 1007, 2228, 1161, 2589,
+		1333, 2993, 1525, 3443, 1739, 3939, 1976, 4482, 2241, 5074, 2535, 5714, 2860, 6402, 3221, 7136,
+		3620, 7916, 4060, 8739, 4544, 9603, 5075, 10507, 5657, 11447, 6291, 12422, 6980, 13428, 7725, 14463,
+		8528, 15526, 9389, 16613, 10309, 17724, 11286, 18857, 12320, 20010, 13407, 21183, 14546, 22373, 15732, 23581,
+		16963, 24806, 18233, 26046, 19537, 27300, 20870, 28568, 22228, 29847, 23604, 31137, 24992, 32435, 26388, 33738,
+		27786, 35046, 29182, 36353, 30570, 37658, 31946, 38957, 33308, 40246, 34650, 41522, 35971, 42781, 37268, 44018,
+		38539, 45231, 39783, 46416, 40997, 47569, 42181, 48688, 43334, 49770, 44456, 50812, 45546, 51812, 46605, 52769,
+		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 1, 0, 1,
+		0, 1, 0, 2, 0, 3, 0, 4, 1, 6, 1, 7, 1, 10, 2, 13,
+		3, 17, 4, 22, 6, 28, 8, 35, 11, 44, 15, 55, 21, 68, 27, 84,
+		36, 103, 47, 127, 60, 155, 77, 189, 97, 231, 122, 280, 151, 341, 186, 413,
+		227, 499, 274, 602, 330, 723, 394, 866, 467, 1034, 550, 1228, 645, 1453, 752, 1711,
+		873, 2004, 1009, 2335, 1161, 2706, 1333, 3119, 1525, 3576, 1740, 4078, 1980, 4625, 2248, 5217,
+		2547, 5855, 2879, 6537, 3249, 7263, 3658, 8031, 4110, 8839, 4608, 9685, 5154, 10568, 5753, 11486,
+		6404, 12437, 7111, 13418, 7875, 14428, 8696, 15466, 9574, 16530, 10509, 17619, 11500, 18733, 12544, 19869,
+		13639, 21029, 14783, 22209, 15971, 23411, 17199, 24634, 18462, 25875, 19756, 27135, 21076, 28411, 22416, 29701,
+		23771, 31005, 25136, 32319, 26506, 33640, 27877, 34965, 29244, 36291, 30603, 37615, 31950, 38931, 33283, 40237,
+		34598, 41528, 35894, 42800, 37167, 44049, 38417, 45271, 39642, 46463, 40840, 47621, 42012, 48742, 43156, 49824,
+		44272, 50864, 45358, 51861, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+		0, 1, 0, 1, 0, 1, 0, 2, 0, 2, 0, 3, 1, 4, 1, 6,
+		1, 8, 2, 10, 2, 14, 4, 18, 5, 22, 7, 28, 9, 36, 13, 45,
+		17, 56, 23, 69, 30, 86, 39, 106, 51, 130, 65, 160, 82, 196, 103, 240,
+		128, 293, 158, 357, 193, 434, 233, 526, 281, 636, 336, 765, 399, 916, 472, 1093,
+		554, 1297, 648, 1532, 754, 1799, 874, 2102, 1010, 2441, 1163, 2820, 1336, 3240, 1530, 3701,
+		1748, 4205, 1992, 4752, 2266, 5341, 2572, 5973, 2914, 6646, 3293, 7360, 3715, 8113, 4180, 8904,
+		4693, 9731, 5256, 10593, 5871, 11489, 6540, 12416, 7264, 13375, 8044, 14363, 8880, 15381, 9772, 16426,
+		10719, 17499, 11719, 18599, 12769, 19725, 13868, 20877, 15011, 22055, 16195, 23258, 17415, 24484, 18666, 25732,
+		19945, 27002, 21246, 28291, 22565, 29597, 23896, 30917, 25235, 32249, 26578, 33589, 27920, 34932, 29259, 36276,
+		30589, 37617, 31909, 38949, 33216, 40268, 34507, 41571, 35780, 42852, 37034, 44109, 38267, 45336, 39478, 46530,
+		40666, 47688, 41830, 48808, 42969, 49886, 44083, 50921, 0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 1, 0, 1, 0, 1, 0, 2, 0, 3,
+		0, 4, 1, 5, 1, 6, 1, 8, 2, 11, 3, 14, 4, 18, 6, 23,
+		8, 29, 11, 37, 14, 46, 19, 57, 25, 71, 33, 88, 43, 110, 55, 135,
+		69, 167, 87, 206, 108, 253, 133, 310, 163, 378, 198, 461, 239, 559, 286, 675,
+		341, 812, 403, 971, 475, 1156, 557, 1369, 650, 1612, 757, 1888, 877, 2198, 1014, 2544,
+		1169, 2928, 1344, 3351, 1541, 3814, 1764, 4316, 2015, 4859, 2296, 5442, 2611, 6065, 2963, 6726,
+		3355, 7426, 3790, 8163, 4270, 8936, 4799, 9744, 5377, 10586, 6008, 11461, 6693, 12369, 7432, 13308,
+		8226, 14279, 9074, 15280, 9976, 16312, 10931, 17374, 11935, 18467, 12987, 19589, 14084, 20741, 15221, 21922,
+		16396, 23130, 17603, 24366, 18838, 25626, 20098, 26911, 21377, 28216, 22672, 29539, 23977, 30877, 25289, 32227,
+		26604, 33585, 27918, 34946, 29229, 36306, 30533, 37660, 31828, 39005, 33112, 40334, 34383, 41645, 35638, 42931,
+		36877, 44190, 38098, 45418, 39300, 46610, 40482, 47764, 41643, 48878, 42782, 49949, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 1, 0, 1,
+		0, 1, 0, 2, 0, 3, 1, 4, 1, 5, 1, 7, 2, 9, 2, 11,
+		3, 15, 5, 19, 7, 24, 9, 30, 12, 38, 16, 47, 21, 59, 28, 74,
+		36, 92, 46, 115, 58, 142, 73, 176, 91, 218, 112, 268, 138, 329, 167, 403,
+		202, 491, 243, 595, 290, 718, 344, 862, 406, 1029, 478, 1222, 560, 1442, 654, 1692,
+		761, 1974, 883, 2290, 1021, 2640, 1179, 3026, 1358, 3449, 1561, 3910, 1790, 4409, 2048, 4945,
+		2339, 5519, 2665, 6131, 3028, 6780, 3433, 7464, 3882, 8185, 4377, 8940, 4920, 9730, 5514, 10554,
+		6159, 11411, 6858, 12302, 7609, 13226, 8414, 14184, 9271, 15175, 10179, 16199, 11136, 17257, 12141, 18348,
+		13189, 19472, 14279, 20629, 15406, 21818, 16566, 23038, 17757, 24287, 18973, 25563, 20211, 26864, 21466, 28187,
+		22734, 29528, 24013, 30885, 25298, 32252, 26586, 33626, 27875, 35002, 29160, 36375, 30441, 37740, 31715, 39092,
+		32981, 40428, 34235, 41741, 35477, 43028, 36706, 44285, 37920, 45509, 39118, 46695, 40299, 47841, 41461, 48945,
+		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+		0, 1, 0, 1, 0, 1, 0, 2, 0, 2, 0, 3, 1, 4, 1, 5,
+		1, 7, 2, 9, 3, 12, 4, 15, 5, 19, 7, 24, 10, 31, 13, 39,
+		18, 49, 23, 62, 30, 77, 38, 97, 49, 121, 61, 151, 76, 187, 94, 232,
+		116, 286, 141, 352, 171, 430, 206, 524, 246, 634, 293, 763, 347, 914, 410, 1088,
+		482, 1287, 564, 1513, 659, 1769, 767, 2055, 892, 2374, 1034, 2725, 1196, 3111, 1380, 3532,
+		1589, 3989, 1826, 4481, 2093, 5009, 2394, 5573, 2732, 6172, 3108, 6807, 3526, 7477, 3988, 8181,
+		4497, 8921, 5054, 9694, 5661, 10503, 6319, 11346, 7028, 12225, 7789, 13139, 8601, 14089, 9462, 15075,
+		10372, 16097, 11328, 17156, 12327, 18252, 13368, 19383, 14446, 20550, 15559, 21752, 16703, 22986, 17873, 24251,
+		19067, 25545, 20281, 26864, 21511, 28204, 22754, 29564, 24007, 30937, 25267, 32319, 26530, 33707, 27795, 35094,
+		29060, 36475, 30322, 37847, 31579, 39203, 32830, 40539, 34073, 41851, 35307, 43134, 36531, 44385, 37743, 45600,
+		38941, 46776, 40125, 47910, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 1, 0, 1, 0, 1, 0, 2, 0, 2,
+		1, 3, 1, 4, 1, 6, 2, 7, 2, 9, 3, 12, 4, 16, 6, 20,
+		8, 25, 11, 32, 15, 41, 19, 52, 25, 65, 32, 82, 40, 103, 51, 129,
+		64, 161, 79, 201, 97, 249, 119, 307, 144, 377, 174, 460, 208, 559, 249, 675,
+		296, 810, 350, 967, 413, 1146, 486, 1350, 570, 1581, 667, 1840, 778, 2129, 906, 2448,
+		1052, 2799, 1219, 3183, 1410, 3599, 1627, 4050, 1873, 4534, 2150, 5052, 2462, 5605, 2811, 6192,
+		3200, 6813, 3631, 7468, 4106, 8159, 4627, 8884, 5196, 9645, 5814, 10443, 6482, 11276, 7199, 12147,
+		7965, 13056, 8779, 14004, 9641, 14990, 10548, 16016, 11498, 17082, 12489, 18186, 13518, 19329, 14582, 20510,
+		15677, 21727, 16801, 22978, 17950, 24261, 19121, 25572, 20311, 26909, 21517, 28267, 22735, 29641, 23964, 31028,
+		25201, 32423, 26443, 33820, 27688, 35213, 28936, 36599, 30183, 37972, 31428, 39327, 32670, 40660, 33907, 41965,
+		35138, 43240, 36362, 44480, 37575, 45683, 38778, 46845, 0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 1, 0, 1,
+		0, 1, 0, 2, 0, 2, 1, 3, 1, 4, 1, 6, 2, 8, 3, 10,
+		4, 13, 5, 16, 7, 21, 9, 27, 12, 34, 16, 43, 21, 55, 26, 69,
+		34, 88, 42, 111, 53, 139, 66, 174, 81, 216, 99, 268, 121, 330, 146, 404,
+		176, 492, 211, 596, 252, 717, 299, 857, 354, 1018, 418, 1202, 493, 1410, 579, 1643,
+		678, 1904, 793, 2193, 925, 2511, 1077, 2859, 1251, 3239, 1449, 3650, 1674, 4092, 1930, 4568,
+		2217, 5076, 2540, 5617, 2901, 6192, 3301, 6801, 3744, 7445, 4231, 8124, 4763, 8839, 5341, 9591,
+		5968, 10381, 6641, 11209, 7363, 12078, 8131, 12987, 8944, 13937, 9802, 14929, 10703, 15963, 11644, 17039,
+		12623, 18157, 13637, 19315, 14683, 20512, 15759, 21746, 16862, 23015, 17989, 24315, 19137, 25643, 20304, 26996,
+		21486, 28368, 22683, 29755, 23890, 31152, 25107, 32554, 26332, 33956, 27563, 35352, 28798, 36737, 30035, 38107,
+		31273, 39456, 32511, 40780, 33747, 42074, 34979, 43337, 36206, 44563, 37426, 45750, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+		0, 1, 0, 1, 0, 1, 0, 1, 0, 2, 1, 3, 1, 3, 1, 5,
+		2, 6, 2, 8, 3, 10, 4, 13, 6, 17, 7, 22, 10, 28, 13, 36,
+		17, 46, 22, 59, 28, 75, 35, 95, 44, 119, 55, 150, 68, 187, 83, 233,
+		102, 288, 123, 354, 149, 432, 179, 525, 214, 633, 255, 758, 304, 902, 360, 1067,
+		426, 1253, 502, 1463, 591, 1698, 694, 1959, 813, 2246, 951, 2562, 1109, 2906, 1290, 3280,
+		1496, 3684, 1730, 4119, 1995, 4585, 2293, 5083, 2627, 5614, 2998, 6179, 3409, 6777, 3862, 7412,
+		4358, 8083, 4899, 8791, 5484, 9538, 6116, 10325, 6793, 11153, 7514, 12024, 8281, 12938, 9090, 13895,
+		9941, 14897, 10832, 15943, 11761, 17033, 12725, 18166, 13722, 19341, 14750, 20555, 15806, 21807, 16887, 23093,
+		17993, 24409, 19119, 25752, 20264, 27118, 21426, 28501, 22604, 29897, 23794, 31300, 24996, 32705, 26208, 34107,
+		27428, 35500, 28655, 36880, 29888, 38241, 31124, 39579, 32363, 40890, 33601, 42171, 34839, 43417, 36073, 44627,
+		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 1, 0, 1, 0, 1, 0, 2, 0, 2,
+		1, 3, 1, 4, 1, 5, 2, 6, 2, 8, 3, 11, 5, 14, 6, 18,
+		8, 23, 11, 30, 14, 39, 18, 50, 23, 64, 29, 81, 37, 103, 46, 130,
+		56, 162, 69, 203, 85, 251, 103, 310, 125, 379, 151, 461, 182, 557, 218, 669,
+		260, 797, 309, 944, 367, 1111, 435, 1300, 515, 1510, 607, 1745, 715, 2004, 839, 2289,
+		982, 2601, 1147, 2940, 1336, 3307, 1551, 3704, 1794, 4131, 2068, 4588, 2376, 5078, 2719, 5600,
+		3100, 6157, 3520, 6748, 3982, 7376, 4485, 8043, 5031, 8748, 5621, 9495, 6254, 10283, 6931, 11116,
+		7650, 11993, 8411, 12916, 9213, 13884, 10054, 14899, 10933, 15960, 11847, 17066, 12795, 18216, 13774, 19408,
+		14783, 20639, 15819, 21907, 16880, 23208, 17966, 24538, 19072, 25893, 20199, 27268, 21345, 28658, 22507, 30058,
+		23684, 31462, 24875, 32865, 26079, 34262, 27294, 35647, 28518, 37017, 29751, 38365, 30989, 39689, 32232, 40984,
+		33477, 42247, 34723, 43475, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 1, 0, 1,
+		0, 1, 0, 2, 0, 2, 1, 3, 1, 4, 1, 5, 2, 7, 3, 9,
+		4, 11, 5, 15, 7, 19, 9, 25, 11, 33, 15, 42, 19, 54, 24, 69,
+		30, 88, 38, 112, 47, 141, 58, 176, 71, 219, 87, 270, 105, 332, 128, 404,
+		154, 490, 185, 589, 222, 703, 266, 834, 317, 983, 377, 1151, 448, 1340, 531, 1550,
+		628, 1783, 740, 2039, 870, 2321, 1020, 2628, 1192, 2961, 1388, 3323, 1611, 3712, 1863, 4132,
+		2146, 4582, 2463, 5065, 2815, 5581, 3204, 6132, 3631, 6719, 4098, 7345, 4606, 8010, 5155, 8717,
+		5746, 9467, 6379, 10262, 7052, 11102, 7766, 11989, 8519, 12924, 9311, 13907, 10140, 14937, 11005, 16014,
+		11903, 17137, 12834, 18303, 13795, 19512, 14786, 20759, 15803, 22041, 16847, 23354, 17914, 24695, 19005, 26057,
+		20117, 27437, 21250, 28829, 22401, 30228, 23570, 31628, 24755, 33024, 25956, 34411, 27170, 35784, 28396, 37139,
+		29632, 38472, 30876, 39778, 32127, 41054, 33381, 42298, 0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+		0, 1, 0, 1, 0, 1, 0, 1, 0, 2, 1, 2, 1, 3, 1, 4,
+		2, 5, 2, 7, 3, 9, 4, 12, 5, 16, 7, 21, 9, 28, 12, 36,
+		16, 46, 20, 59, 25, 76, 31, 96, 39, 122, 48, 153, 59, 190, 73, 236,
+		89, 290, 108, 354, 130, 429, 158, 517, 190, 618, 228, 734, 273, 867, 327, 1017,
+		390, 1185, 464, 1373, 551, 1582, 652, 1812, 770, 2065, 906, 2343, 1062, 2645, 1241, 2973,
+		1445, 3328, 1676, 3712, 1936, 4126, 2227, 4571, 2551, 5049, 2910, 5561, 3305, 6109, 3738, 6696,
+		4209, 7323, 4719, 7991, 5268, 8703, 5857, 9460, 6486, 10264, 7154, 11116, 7860, 12016, 8604, 12965,
+		9384, 13963, 10199, 15010, 11049, 16103, 11932, 17243, 12846, 18425, 13790, 19648, 14764, 20908, 15765, 22201,
+		16793, 23523, 17847, 24869, 18925, 26235, 20026, 27615, 21150, 29004, 22295, 30397, 23461, 31788, 24645, 33172,
+		25846, 34546, 27063, 35903, 28294, 37241, 29538, 38554, 30791, 39840, 32051, 41096, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 1, 0, 1, 0, 1, 0, 1, 0, 2,
+		1, 2, 1, 3, 1, 4, 2, 6, 2, 8, 3, 10, 4, 13, 6, 18,
+		8, 23, 10, 30, 13, 39, 16, 51, 21, 65, 26, 83, 32, 105, 40, 132,
+		49, 165, 61, 205, 74, 252, 91, 309, 110, 375, 134, 452, 162, 542, 196, 645,
+		236, 762, 283, 895, 339, 1045, 406, 1213, 484, 1399, 575, 1606, 681, 1833, 804, 2083,
+		946, 2356, 1109, 2654, 1295, 2977, 1506, 3328, 1744, 3707, 2011, 4117, 2309, 4559, 2639, 5034,
+		3003, 5546, 3402, 6095, 3837, 6684, 4310, 7315, 4819, 7990, 5367, 8710, 5952, 9478, 6575, 10294,
+		7235, 11159, 7932, 12074, 8664, 13039, 9432, 14053, 10233, 15116, 11068, 16225, 11936, 17379, 12835, 18575,
+		13764, 19810, 14723, 21079, 15711, 22379, 16727, 23705, 17771, 25053, 18840, 26417, 19935, 27792, 21055, 29173,
+		22198, 30556, 23364, 31934, 24551, 33303, 25757, 34659, 26981, 35997, 28220, 37315, 29473, 38607, 30737, 39873,
+		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 1, 0, 1,
+		0, 1, 0, 1, 1, 2, 1, 3, 1, 4, 1, 5, 2, 6, 3, 8,
+		4, 11, 5, 15, 6, 20, 8, 26, 10, 33, 13, 43, 17, 56, 21, 71,
+		27, 90, 33, 114, 41, 143, 51, 178, 62, 219, 76, 269, 93, 327, 114, 395,
+		139, 474, 168, 565, 203, 669, 245, 786, 295, 919, 354, 1068, 424, 1235, 506, 1419,
+		602, 1623, 713, 1848, 842, 2094, 990, 2363, 1159, 2657, 1351, 2977, 1568, 3324, 1812, 3701,
+		2085, 4109, 2388, 4550, 2722, 5026, 3090, 5539, 3491, 6093, 3927, 6687, 4399, 7326, 4906, 8010,
+		5449, 8742, 6029, 9522, 6644, 10352, 7295, 11232, 7981, 12162, 8702, 13143, 9457, 14173, 10245, 15250,
+		11067, 16374, 11921, 17540, 12806, 18746, 13723, 19989, 14671, 21263, 15649, 22566, 16657, 23892, 17694, 25236,
+		18759, 26594, 19852, 27960, 20972, 29329, 22118, 30696, 23288, 32058, 24481, 33408, 25695, 34744, 26928, 36062,
+		28177, 37358, 29441, 38629, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 1, 0, 1, 0, 1, 0, 2, 1, 2, 1, 3, 1, 4,
+		2, 5, 2, 7, 3, 9, 4, 12, 5, 16, 7, 22, 8, 28, 11, 37,
+		14, 47, 17, 61, 22, 78, 27, 98, 34, 123, 42, 154, 52, 190, 64, 233,
+		79, 284, 97, 344, 118, 413, 144, 493, 175, 585, 212, 689, 257, 806, 310, 939,
+		372, 1086, 445, 1251, 531, 1433, 632, 1635, 748, 1857, 882, 2100, 1035, 2366, 1210, 2658,
+		1408, 2975, 1630, 3321, 1879, 3697, 2157, 4105, 2463, 4548, 2800, 5028, 3169, 5546, 3571, 6106,
+		4006, 6709, 4474, 7358, 4978, 8054, 5515, 8798, 6087, 9593, 6694, 10437, 7335, 11333, 8010, 12279,
+		8720, 13274, 9463, 14318, 10239, 15408, 11049, 16542, 11891, 17717, 12766, 18930, 13674, 20177, 14614, 21452,
+		15586, 22753, 16590, 24074, 17625, 25410, 18690, 26757, 19785, 28109, 20908, 29462, 22060, 30812, 23237, 32154,
+		24439, 33484, 25662, 34798, 26906, 36094, 28167, 37368,
+	}
+}
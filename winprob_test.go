@@ -0,0 +1,33 @@
+package poner_test
+
+import (
+	"math"
+	"testing"
+
+	"github.com/blakecallens/poner"
+)
+
+func TestEstimateWinProbability(t *testing.T) {
+	dealer := poner.EstimateWinProbability(0, 0, true, 121)
+	if dealer <= 0.5 || dealer >= 0.6 {
+		t.Errorf("Error estimating win probability, got %v for the first dealer, want between 0.5 and 0.6", dealer)
+	}
+	for _, scores := range [][2]int{{0, 0}, {100, 90}, {115, 119}, {60, 120}} {
+		mine := poner.EstimateWinProbability(scores[0], scores[1], true, 121)
+		theirs := poner.EstimateWinProbability(scores[1], scores[0], false, 121)
+		if math.Abs(mine+theirs-1) > 1e-6 {
+			t.Errorf("Error estimating win probability for %v, got %v and %v, want a sum of 1", scores, mine, theirs)
+		}
+	}
+	if poner.EstimateWinProbability(100, 90, false, 121) <= poner.EstimateWinProbability(95, 90, false, 121) {
+		t.Error("Error estimating win probability, more points should be more likely to win")
+	}
+	// His heels and the pegging come before the pone counts, so the dealer
+	// needing one point beats the pone needing two
+	if poner.EstimateWinProbability(119, 120, false, 121) > 0.5 {
+		t.Error("Error estimating win probability, the dealer should peg out first")
+	}
+	if poner.EstimateWinProbability(121, 0, false, 121) != 1 || poner.EstimateWinProbability(0, 61, true, 61) != 0 {
+		t.Error("Error estimating win probability for finished games")
+	}
+}
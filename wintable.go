@@ -0,0 +1,220 @@
+package poner
+
+//go:generate go run ./cmd/wintable -variant Six-Card -out winprob_table_121.go
+
+import (
+	"bufio"
+	"fmt"
+	"io"
+	"math"
+	"math/rand"
+	"sync"
+)
+
+// simulatedGameRounds is how many rounds are simulated in each game before starting a new one
+const simulatedGameRounds = 100
+
+// winTableData holds the generated win tables for each game length, with each
+// chance scaled to the range of a uint16
+var winTableData = map[int][]uint16{}
+
+// precomputedTables caches the decoded generated win tables
+var precomputedTables = struct {
+	sync.Mutex
+	tables map[int]*WinTable
+}{tables: map[int]*WinTable{}}
+
+// WinProbability returns the chance of winning a two player game from the
+// start of a round, given both scores and whether the player deals the round.
+// Game lengths without a generated table fall back to EstimateWinProbability
+func WinProbability(myScore int, opponentScore int, dealer bool, toWin int) float64 {
+	table := getPrecomputedTable(toWin)
+	if table == nil {
+		return EstimateWinProbability(myScore, opponentScore, dealer, toWin)
+	}
+	return table.Chance(myScore, opponentScore, dealer)
+}
+
+// getPrecomputedTable decodes the generated table for a game length, or returns nil if there isn't one
+func getPrecomputedTable(toWin int) *WinTable {
+	precomputedTables.Lock()
+	defer precomputedTables.Unlock()
+	if table, ok := precomputedTables.tables[toWin]; ok {
+		return table
+	}
+	data, ok := winTableData[toWin]
+	if !ok || len(data) != (toWin+1)*(toWin+1)*2 {
+		return nil
+	}
+	table := &WinTable{ToWin: toWin, Chances: make([]float64, len(data))}
+	for ii, chance := range data {
+		table.Chances[ii] = float64(chance) / math.MaxUint16
+	}
+	precomputedTables.tables[toWin] = table
+	return table
+}
+
+// SimulateWinTable builds a win table for a variant's game length from the
+// points scored in rounds of simulated games between two computer players,
+// counted in the order they were scored
+func SimulateWinTable(rules Rules, rounds int, random *rand.Rand) (table *WinTable, err error) {
+	rules = rules.orDefault()
+	err = rules.Validate(2)
+	if err != nil {
+		err = fmt.Errorf("SimulateWinTable:: %v", err)
+		return
+	}
+	model, err := simulateRounds(rules, rounds, random)
+	if err != nil {
+		return
+	}
+	table = buildWinTable(rules.ToWin, model)
+	return
+}
+
+// simulateRounds plays rounds between two computer players with the
+// engine's game loop, returning a model of the points the pone and dealer
+// score in a round and of who reaches each number of points first
+func simulateRounds(rules Rules, rounds int, random *rand.Rand) (model *roundModel, err error) {
+	if rounds < 1 {
+		err = fmt.Errorf("SimulateWinTable:: invalid number of rounds %v", rounds)
+		return
+	}
+	if random == nil {
+		random = newRandom()
+	}
+
+	counts := map[[2]int]int{}
+	paths := [][]roundScore{}
+	for played := 0; played < rounds; {
+		game := Game{Random: random, ToWin: math.MaxInt32, Rules: rules}
+		game.New([]Player{
+			{Name: "Pone", IsComputer: true, SkillLevel: 4},
+			{Name: "Dealer", IsComputer: true, SkillLevel: 4},
+		})
+		for round := 0; round < simulatedGameRounds && played < rounds; round++ {
+			start := len(game.Events)
+			err = game.playComputerRound()
+			if err != nil {
+				return
+			}
+			path := game.roundPath(game.Events[start:])
+			pone, dealer := 0, 0
+			for _, score := range path {
+				if score.pone {
+					pone += score.points
+				} else {
+					dealer += score.points
+				}
+			}
+			counts[[2]int{pone, dealer}]++
+			paths = append(paths, path)
+			played++
+		}
+	}
+
+	points := []roundPoints{}
+	for key, count := range counts {
+		points = append(points, roundPoints{pone: key[0], dealer: key[1], chance: float64(count) / float64(rounds)})
+	}
+	model = newRoundModel(points)
+	for _, path := range paths {
+		model.addPath(path, 1/float64(rounds))
+	}
+	return
+}
+
+// roundScore is points scored by the pone or the dealer during a round
+type roundScore struct {
+	pone   bool
+	points int
+}
+
+// roundPath returns the points scored in a round's events, in order. The
+// points the pone starts the game with are given before the first round, so
+// they aren't part of it
+func (game *Game) roundPath(events []Event) (path []roundScore) {
+	path = []roundScore{}
+	dealer := game.Dealer
+	for _, event := range events {
+		switch event.Type {
+		case EventDeal:
+			dealer = event.Dealer
+		case EventThreeForLast:
+			continue
+		}
+		if points := scoresTotal(event.Scores); points > 0 {
+			path = append(path, roundScore{pone: event.Player != dealer, points: points})
+		}
+	}
+	return
+}
+
+// addPath adds the chance of a round's scores to who reaches each number of points first
+func (model *roundModel) addPath(path []roundScore, chance float64) {
+	// reached holds the step of the round where each number of points is
+	// reached, or the length of the round for points that aren't
+	poneReached := make([]int, len(model.poneFirst))
+	dealerReached := make([]int, len(model.poneFirst[0]))
+	pone, dealer := 0, 0
+	for step, score := range path {
+		reached, total := dealerReached, &dealer
+		if score.pone {
+			reached, total = poneReached, &pone
+		}
+		for points := *total + 1; points <= *total+score.points && points < len(reached); points++ {
+			reached[points] = step + 1
+		}
+		*total += score.points
+	}
+	for points := pone + 1; points < len(poneReached); points++ {
+		poneReached[points] = len(path) + 1
+	}
+	for points := dealer + 1; points < len(dealerReached); points++ {
+		dealerReached[points] = len(path) + 1
+	}
+
+	for poneNeeded := 1; poneNeeded < len(poneReached); poneNeeded++ {
+		for dealerNeeded := 1; dealerNeeded < len(dealerReached); dealerNeeded++ {
+			switch {
+			case poneReached[poneNeeded] < dealerReached[dealerNeeded]:
+				model.poneFirst[poneNeeded][dealerNeeded] += chance
+			case dealerReached[dealerNeeded] < poneReached[poneNeeded]:
+				model.dealerFirst[poneNeeded][dealerNeeded] += chance
+			}
+		}
+	}
+}
+
+// playComputerRound applies computer actions until the round is over
+func (game *Game) playComputerRound() (err error) {
+	for {
+		var action Action
+		action, err = game.ComputerAction()
+		if err != nil {
+			return
+		}
+		_, err = game.Apply(action)
+		if err != nil || game.Phase == PhaseDeal || game.Phase == PhaseGameOver {
+			return
+		}
+	}
+}
+
+// WriteSource writes the table as generated Go source that registers it for WinProbability
+func (table *WinTable) WriteSource(writer io.Writer, command string) (err error) {
+	buffer := bufio.NewWriter(writer)
+	fmt.Fprintf(buffer, "// Code generated by %v; DO NOT EDIT.\n\n", command)
+	fmt.Fprintf(buffer, "package poner\n\n")
+	fmt.Fprintf(buffer, "func init() {\n\twinTableData[%v] = []uint16{", table.ToWin)
+	for ii, chance := range table.Chances {
+		if ii%16 == 0 {
+			fmt.Fprintf(buffer, "\n\t\t")
+		} else {
+			fmt.Fprintf(buffer, " ")
+		}
+		fmt.Fprintf(buffer, "%v,", int(math.Round(chance*math.MaxUint16)))
+	}
+	fmt.Fprintf(buffer, "\n\t}\n}\n")
+	return buffer.Flush()
+}
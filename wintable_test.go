@@ -0,0 +1,97 @@
+package poner_test
+
+import (
+	"bytes"
+	"math"
+	"math/rand"
+	"strings"
+	"testing"
+
+	"github.com/blakecallens/poner"
+)
+
+func TestSimulateWinTable(t *testing.T) {
+	table, err := poner.SimulateWinTable(poner.FiveCardRules, 300, rand.New(rand.NewSource(3)))
+	if err != nil {
+		t.Errorf("Error simulating win table: %v", err)
+		return
+	}
+	dealer := table.Chance(0, 0, true)
+	if dealer < 0.45 || dealer > 0.7 {
+		t.Errorf("Error simulating win table, got %v for the first dealer, want between 0.45 and 0.7", dealer)
+	}
+	if math.Abs(dealer+table.Chance(0, 0, false)-1) > 1e-6 {
+		t.Errorf("Error simulating win table, got %v and %v, want a sum of 1", dealer, table.Chance(0, 0, false))
+	}
+	if table.Chance(61, 50, false) != 1 || table.Chance(50, 61, true) != 0 {
+		t.Error("Error simulating win table, finished games should be decided")
+	}
+
+	_, err = poner.SimulateWinTable(poner.FiveCardRules, 0, nil)
+	if err == nil {
+		t.Error("Error simulating win table, got no error for no rounds")
+	}
+
+	buffer := bytes.Buffer{}
+	err = table.WriteSource(&buffer, "wintable -towin 61")
+	if err != nil {
+		t.Errorf("Error writing win table: %v", err)
+		return
+	}
+	source := buffer.String()
+	if !strings.Contains(source, "DO NOT EDIT") || !strings.Contains(source, "winTableData[61]") {
+		t.Error("Error writing win table, generated source is missing its header or table")
+	}
+}
+
+func TestWinProbability(t *testing.T) {
+	for _, scores := range [][2]int{{0, 0}, {100, 90}, {115, 119}} {
+		mine := poner.WinProbability(scores[0], scores[1], true, 121)
+		theirs := poner.WinProbability(scores[1], scores[0], false, 121)
+		if math.Abs(mine+theirs-1) > 1e-3 {
+			t.Errorf("Error getting win probability for %v, got %v and %v, want a sum of 1", scores, mine, theirs)
+		}
+	}
+	if poner.WinProbability(120, 100, false, 121) < 0.99 {
+		t.Error("Error getting win probability, the pone needing one point should win")
+	}
+	// Game lengths without a table use the analytic estimate
+	if poner.WinProbability(40, 50, true, 61) != poner.EstimateWinProbability(40, 50, true, 61) {
+		t.Error("Error getting win probability, want the estimate without a table")
+	}
+}
+
+// simulatePoneWins plays games from the start of a round with computer
+// players and returns how often the pone wins
+func simulatePoneWins(t *testing.T, poneScore int, dealerScore int, games int) float64 {
+	wins := 0
+	for seed := 0; seed < games; seed++ {
+		game := poner.Game{}
+		game.SetSeed(int64(seed))
+		err := game.SetFirstDealer(1)
+		if err != nil {
+			t.Fatalf("Error setting first dealer: %v", err)
+		}
+		game.New([]poner.Player{
+			{Name: "Bob", IsComputer: true, SkillLevel: 4, Score: poneScore},
+			{Name: "Sue", IsComputer: true, SkillLevel: 4, Score: dealerScore},
+		})
+		playUntilOver(t, &game)
+		if game.Winner == &game.Players[0] {
+			wins++
+		}
+	}
+	return float64(wins) / float64(games)
+}
+
+func TestWinProbabilityNearFinish(t *testing.T) {
+	// Near the finish the dealer's his heels and the order of the pegging
+	// decide most games, before the pone can count their hand
+	for _, scores := range [][2]int{{120, 120}, {116, 116}, {119, 117}, {110, 112}} {
+		simulated := simulatePoneWins(t, scores[0], scores[1], 400)
+		table := poner.WinProbability(scores[0], scores[1], false, 121)
+		if math.Abs(table-simulated) > 0.07 {
+			t.Errorf("Error getting win probability for the pone at %v, got %.3f, simulated %.3f", scores, table, simulated)
+		}
+	}
+}